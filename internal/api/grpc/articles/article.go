@@ -0,0 +1,125 @@
+package articles
+
+import (
+	"context"
+	"fmt"
+	"github.com/nogavadu/articles-service/internal/domain/converter"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	articleService "github.com/nogavadu/articles-service/internal/service/article"
+	desc "github.com/nogavadu/articles-service/pkg/articles_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"strings"
+)
+
+func (i *Implementation) CreateArticle(ctx context.Context, req *desc.CreateArticleRequest) (*desc.CreateResponse, error) {
+	if req.GetBody() == nil {
+		return nil, status.Error(codes.InvalidArgument, "article body is required")
+	}
+
+	relations := []model.ArticleRelation{{
+		CropId:     int(req.GetCropId()),
+		CategoryId: int(req.GetCategoryId()),
+	}}
+
+	body, err := converter.ProtoToArticleBody(req.GetBody())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	id, err := i.articleServ.Create(ctx, relations, body)
+	if err != nil {
+		return nil, serviceErr(err)
+	}
+
+	return &desc.CreateResponse{Id: int64(id)}, nil
+}
+
+func (i *Implementation) GetArticle(ctx context.Context, req *desc.GetArticleRequest) (*desc.GetArticleResponse, error) {
+	article, err := i.articleServ.GetById(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, serviceErr(err)
+	}
+
+	return &desc.GetArticleResponse{Article: converter.ToProtoArticle(article)}, nil
+}
+
+func (i *Implementation) ListArticles(ctx context.Context, req *desc.ListArticlesRequest) (*desc.ListArticlesResponse, error) {
+	params, err := listArticlesParams(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	list, err := i.articleServ.GetAll(ctx, params)
+	if err != nil {
+		return nil, serviceErr(err)
+	}
+
+	resp := &desc.ListArticlesResponse{
+		Articles:   make([]*desc.Article, 0, len(list.Articles)),
+		NextCursor: converter.StringPtrToProtoString(list.NextCursor),
+		Total:      int64(list.Total),
+	}
+	for idx := range list.Articles {
+		resp.Articles = append(resp.Articles, converter.ToProtoArticle(&list.Articles[idx]))
+	}
+
+	return resp, nil
+}
+
+func (i *Implementation) UpdateArticle(ctx context.Context, req *desc.UpdateArticleRequest) (*emptypb.Empty, error) {
+	input, err := converter.ProtoToArticleUpdateInput(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if input.Title == nil && input.LatinName == nil && input.Text == nil && input.Format == nil && input.ImageIds == nil &&
+		input.Status == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request body")
+	}
+
+	if err := i.articleServ.Update(ctx, int(req.GetId()), input); err != nil {
+		return nil, serviceErr(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) DeleteArticle(ctx context.Context, req *desc.DeleteArticleRequest) (*emptypb.Empty, error) {
+	if err := i.articleServ.Delete(ctx, int(req.GetId())); err != nil {
+		return nil, serviceErr(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// listArticlesParams mirrors the query params of the HTTP articles list.
+func listArticlesParams(req *desc.ListArticlesRequest) (*model.ArticleGetAllParams, error) {
+	params := &model.ArticleGetAllParams{
+		CropId:     converter.ProtoInt64ToPtrInt(req.GetCropId()),
+		CategoryId: converter.ProtoInt64ToPtrInt(req.GetCategoryId()),
+		Status:     converter.ProtoStringToPtrString(req.GetStatus()),
+		Cursor:     converter.ProtoStringToPtrString(req.GetCursor()),
+	}
+
+	if limit := int(req.GetLimit()); limit != 0 {
+		if limit < 0 || limit > articleService.MaxPageLimit {
+			return nil, fmt.Errorf("limit must be between 1 and %d", articleService.MaxPageLimit)
+		}
+		params.Limit = limit
+	}
+
+	if req.GetQuery() != nil {
+		q := strings.TrimSpace(req.GetQuery().GetValue())
+		if q != "" {
+			params.Query = &q
+		}
+	}
+
+	if sort := req.GetSort().GetValue(); sort != "" {
+		params.Sort = strings.TrimPrefix(sort, "-")
+		params.Desc = strings.HasPrefix(sort, "-")
+	}
+
+	return params, nil
+}
@@ -0,0 +1,76 @@
+package articles
+
+import (
+	"context"
+	"github.com/nogavadu/articles-service/internal/domain/converter"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	desc "github.com/nogavadu/articles-service/pkg/articles_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) CreateCategory(ctx context.Context, req *desc.CreateCategoryRequest) (*desc.CreateResponse, error) {
+	if req.GetInfo() == nil {
+		return nil, status.Error(codes.InvalidArgument, "category info is required")
+	}
+
+	info, err := converter.ProtoToCategoryInfo(req.GetInfo())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	id, err := i.categoryServ.Create(ctx, info, &model.CategoryCreateParams{
+		CropId: converter.ProtoInt64ToPtrInt(req.GetCropId()),
+	})
+	if err != nil {
+		return nil, serviceErr(err)
+	}
+
+	return &desc.CreateResponse{Id: int64(id)}, nil
+}
+
+func (i *Implementation) GetCategory(ctx context.Context, req *desc.GetCategoryRequest) (*desc.GetCategoryResponse, error) {
+	category, err := i.categoryServ.GetById(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, serviceErr(err)
+	}
+
+	return &desc.GetCategoryResponse{Category: converter.ToProtoCategory(category)}, nil
+}
+
+func (i *Implementation) ListCategories(ctx context.Context, req *desc.ListCategoriesRequest) (*desc.ListCategoriesResponse, error) {
+	categories, err := i.categoryServ.GetAll(ctx, &model.CategoryGetAllParams{
+		CropId: converter.ProtoInt64ToPtrInt(req.GetCropId()),
+		Status: converter.ProtoStringToPtrString(req.GetStatus()),
+	})
+	if err != nil {
+		return nil, serviceErr(err)
+	}
+
+	return &desc.ListCategoriesResponse{Categories: converter.ToProtoCategories(categories)}, nil
+}
+
+func (i *Implementation) UpdateCategory(ctx context.Context, req *desc.UpdateCategoryRequest) (*emptypb.Empty, error) {
+	input, err := converter.ProtoToUpdateCategoryInput(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if input.Name == nil && input.Description == nil && input.IconId == nil && input.Status == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request body")
+	}
+
+	if err := i.categoryServ.Update(ctx, int(req.GetId()), input); err != nil {
+		return nil, serviceErr(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) DeleteCategory(ctx context.Context, req *desc.DeleteCategoryRequest) (*emptypb.Empty, error) {
+	if err := i.categoryServ.Delete(ctx, int(req.GetId())); err != nil {
+		return nil, serviceErr(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
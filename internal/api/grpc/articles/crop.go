@@ -0,0 +1,94 @@
+package articles
+
+import (
+	"context"
+	"github.com/nogavadu/articles-service/internal/domain/converter"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	desc "github.com/nogavadu/articles-service/pkg/articles_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (i *Implementation) CreateCrop(ctx context.Context, req *desc.CreateCropRequest) (*desc.CreateResponse, error) {
+	if req.GetInfo() == nil {
+		return nil, status.Error(codes.InvalidArgument, "crop info is required")
+	}
+
+	info, err := converter.ProtoToCropInfo(req.GetInfo())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	id, err := i.cropServ.Create(ctx, info)
+	if err != nil {
+		return nil, serviceErr(err)
+	}
+
+	return &desc.CreateResponse{Id: int64(id)}, nil
+}
+
+func (i *Implementation) GetCrop(ctx context.Context, req *desc.GetCropRequest) (*desc.GetCropResponse, error) {
+	crop, err := i.cropServ.GetById(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, serviceErr(err)
+	}
+
+	return &desc.GetCropResponse{Crop: converter.ToProtoCrop(crop)}, nil
+}
+
+func (i *Implementation) ListCrops(ctx context.Context, req *desc.ListCropsRequest) (*desc.ListCropsResponse, error) {
+	crops, err := i.cropServ.GetAll(ctx, &model.CropGetAllParams{
+		Status: converter.ProtoStringToPtrString(req.GetStatus()),
+	})
+	if err != nil {
+		return nil, serviceErr(err)
+	}
+
+	resp := &desc.ListCropsResponse{Crops: make([]*desc.Crop, 0, len(crops))}
+	for idx := range crops {
+		resp.Crops = append(resp.Crops, converter.ToProtoCrop(&crops[idx]))
+	}
+
+	return resp, nil
+}
+
+func (i *Implementation) UpdateCrop(ctx context.Context, req *desc.UpdateCropRequest) (*emptypb.Empty, error) {
+	input, err := converter.ProtoToUpdateCropInput(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if input.Name == nil && input.Description == nil && input.ImgId == nil && input.Status == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request body")
+	}
+
+	if err := i.cropServ.Update(ctx, int(req.GetId()), input); err != nil {
+		return nil, serviceErr(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) DeleteCrop(ctx context.Context, req *desc.DeleteCropRequest) (*emptypb.Empty, error) {
+	if err := i.cropServ.Delete(ctx, int(req.GetId())); err != nil {
+		return nil, serviceErr(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) AddCropCategory(ctx context.Context, req *desc.CropCategoryRequest) (*emptypb.Empty, error) {
+	if err := i.cropServ.AddRelation(ctx, int(req.GetCropId()), int(req.GetCategoryId())); err != nil {
+		return nil, serviceErr(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (i *Implementation) RemoveCropCategory(ctx context.Context, req *desc.CropCategoryRequest) (*emptypb.Empty, error) {
+	if err := i.cropServ.RemoveRelation(ctx, int(req.GetCropId()), int(req.GetCategoryId())); err != nil {
+		return nil, serviceErr(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
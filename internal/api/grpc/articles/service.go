@@ -0,0 +1,62 @@
+package articles
+
+import (
+	"errors"
+	"github.com/nogavadu/articles-service/internal/lib/apperr"
+	"github.com/nogavadu/articles-service/internal/service"
+	desc "github.com/nogavadu/articles-service/pkg/articles_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PublicMethods can be called without an auth token.
+var PublicMethods = []string{
+	desc.ArticlesV1_GetCrop_FullMethodName,
+	desc.ArticlesV1_ListCrops_FullMethodName,
+	desc.ArticlesV1_GetCategory_FullMethodName,
+	desc.ArticlesV1_ListCategories_FullMethodName,
+	desc.ArticlesV1_GetArticle_FullMethodName,
+	desc.ArticlesV1_ListArticles_FullMethodName,
+}
+
+type Implementation struct {
+	desc.UnimplementedArticlesV1Server
+	cropServ     service.CropService
+	categoryServ service.CategoryService
+	articleServ  service.ArticleService
+}
+
+func New(
+	cropService service.CropService,
+	categoryService service.CategoryService,
+	articleService service.ArticleService,
+) *Implementation {
+	return &Implementation{
+		cropServ:     cropService,
+		categoryServ: categoryService,
+		articleServ:  articleService,
+	}
+}
+
+// serviceErr maps the kind of a service error to a gRPC status.
+func serviceErr(err error) error {
+	var serviceErr *apperr.Error
+	if !errors.As(err, &serviceErr) {
+		return status.Error(codes.Internal, "internal server error")
+	}
+
+	switch {
+	case errors.Is(err, apperr.ErrInvalidArguments):
+		return status.Error(codes.InvalidArgument, serviceErr.Error())
+	case errors.Is(err, apperr.ErrNotFound):
+		return status.Error(codes.NotFound, serviceErr.Error())
+	case errors.Is(err, apperr.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, serviceErr.Error())
+	case errors.Is(err, apperr.ErrAccessDenied):
+		return status.Error(codes.PermissionDenied, serviceErr.Error())
+	case errors.Is(err, apperr.ErrIllegalTransition), errors.Is(err, apperr.ErrConflict):
+		return status.Error(codes.FailedPrecondition, serviceErr.Error())
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
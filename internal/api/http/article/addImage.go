@@ -0,0 +1,47 @@
+package article
+
+import (
+	"encoding/json"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/request"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type addImageResponse struct {
+	Id int `json:"id"`
+}
+
+func (i *Implementation) AddImageHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.Atoi(chi.URLParam(r, "articleId"))
+		if err != nil {
+			response.Err(w, r, "invalid article id", http.StatusBadRequest)
+			return
+		}
+
+		var reqData model.ArticleImageInput
+		if err = json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+			response.Err(w, r, "invalid request body format", http.StatusBadRequest)
+			return
+		}
+		if err = request.Validate(&reqData); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		imageId, err := i.articleServ.AddImage(r.Context(), id, &reqData)
+		if err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, &addImageResponse{
+			Id: imageId,
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package article
+
+import (
+	"encoding/json"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/request"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type addRelationResponse struct {
+	Status string `json:"status"`
+}
+
+func (i *Implementation) AddRelationHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		idStr := chi.URLParam(r, "articleId")
+		if idStr == "" {
+			response.Err(w, r, "article id is required", http.StatusBadRequest)
+			return
+		}
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			response.Err(w, r, "invalid article id", http.StatusBadRequest)
+			return
+		}
+
+		var reqData model.ArticleRelation
+		if err = json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+			response.Err(w, r, "invalid request body format", http.StatusBadRequest)
+			return
+		}
+		if err = request.Validate(&reqData); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		if err = i.articleServ.AddRelation(r.Context(), id, &reqData); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, &addRelationResponse{
+			Status: "ok",
+		})
+	}
+}
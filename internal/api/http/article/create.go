@@ -2,20 +2,19 @@ package article
 
 import (
 	"encoding/json"
-	"errors"
 	"github.com/go-chi/render"
-	"github.com/go-playground/validator/v10"
 	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/request"
 	"github.com/nogavadu/articles-service/internal/lib/api/response"
-	articleServ "github.com/nogavadu/articles-service/internal/service/article"
 	"net/http"
 )
 
 type createRequest struct {
-	UserId      int               `json:"user_id" validate:"required"`
-	CropId      int               `json:"crop_id" validate:"required"`
-	CategoryId  int               `json:"category_id" validate:"required"`
-	ArticleBody model.ArticleBody `json:"article_body" validate:"required"`
+	UserId      *int                    `json:"user_id,omitempty"`
+	CropId      int                     `json:"crop_id,omitempty" validate:"required_without=Relations"`
+	CategoryId  int                     `json:"category_id,omitempty" validate:"required_with=CropId"`
+	Relations   []model.ArticleRelation `json:"relations,omitempty" validate:"omitempty,dive"`
+	ArticleBody model.ArticleBody       `json:"article_body" validate:"required"`
 }
 
 type createResponse struct {
@@ -29,19 +28,26 @@ func (i *Implementation) CreateHandler() http.HandlerFunc {
 			response.Err(w, r, "invalid request body format", http.StatusBadRequest)
 			return
 		}
-		if err := validator.New().Struct(&reqData); err != nil {
-			response.Err(w, r, "invalid arguments", http.StatusBadRequest)
+		if err := request.Validate(&reqData); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+		if err := request.CheckUserId(r, reqData.UserId); err != nil {
+			response.Err(w, r, err.Error(), http.StatusBadRequest)
 			return
 		}
 
-		id, err := i.articleServ.Create(r.Context(), reqData.UserId, reqData.CropId, reqData.CategoryId, &reqData.ArticleBody)
-		if err != nil {
-			if errors.Is(err, articleServ.ErrInvalidArguments) || errors.Is(err, articleServ.ErrAlreadyExists) {
-				response.Err(w, r, err.Error(), http.StatusBadRequest)
-				return
-			}
+		relations := reqData.Relations
+		if reqData.CropId != 0 {
+			relations = append(relations, model.ArticleRelation{
+				CropId:     reqData.CropId,
+				CategoryId: reqData.CategoryId,
+			})
+		}
 
-			response.Err(w, r, err.Error(), http.StatusInternalServerError)
+		id, err := i.articleServ.Create(r.Context(), relations, &reqData.ArticleBody)
+		if err != nil {
+			response.Error(w, r, err)
 			return
 		}
 
@@ -28,7 +28,7 @@ func (i *Implementation) DeleteHandler() http.HandlerFunc {
 
 		err = i.articleServ.Delete(r.Context(), id)
 		if err != nil {
-			response.Err(w, r, err.Error(), http.StatusBadRequest)
+			response.Error(w, r, err)
 			return
 		}
 
@@ -0,0 +1,50 @@
+package article
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type diffRevisionsResponse struct {
+	*model.ArticleRevisionDiff
+}
+
+func (i *Implementation) DiffRevisionsHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		idStr := chi.URLParam(r, "articleId")
+		if idStr == "" {
+			response.Err(w, r, "article id is required", http.StatusBadRequest)
+			return
+		}
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			response.Err(w, r, "invalid article id", http.StatusBadRequest)
+			return
+		}
+
+		fromId, err := strconv.Atoi(r.URL.Query().Get("from"))
+		if err != nil {
+			response.Err(w, r, "invalid from query param", http.StatusBadRequest)
+			return
+		}
+		toId, err := strconv.Atoi(r.URL.Query().Get("to"))
+		if err != nil {
+			response.Err(w, r, "invalid to query param", http.StatusBadRequest)
+			return
+		}
+
+		revisionDiff, err := i.articleServ.DiffRevisions(r.Context(), id, fromId, toId)
+		if err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &diffRevisionsResponse{
+			ArticleRevisionDiff: revisionDiff,
+		})
+	}
+}
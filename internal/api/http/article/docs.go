@@ -0,0 +1,173 @@
+package article
+
+import (
+	"fmt"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/openapi"
+	articleService "github.com/nogavadu/articles-service/internal/service/article"
+	"net/http"
+)
+
+// Operations documents the article routes relative to their mount point.
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method:  http.MethodGet,
+			Path:    "/",
+			Summary: "List articles",
+			Query: []openapi.Param{
+				{Name: "crop_id", Type: "integer", Description: "Only articles about this crop"},
+				{Name: "include_parent_crops", Type: "boolean", Description: "Also articles about the crops crop_id is a variety of"},
+				{Name: "category_id", Type: "integer", Description: "Only articles in this category"},
+				{Name: "include_descendants", Type: "boolean", Description: "Also articles in the subcategories of category_id"},
+				{Name: "status", Description: "Only articles with this status, review by default"},
+				{Name: "q", Description: "Full text search query"},
+				{
+					Name:        "limit",
+					Type:        "integer",
+					Description: fmt.Sprintf("Page size, %d by default and at most %d", articleService.DefaultPageLimit, articleService.MaxPageLimit),
+				},
+				{Name: "cursor", Description: "next_cursor of the previous page"},
+				{Name: "sort", Description: "created_at, updated_at, title or rank, prefixed with - for descending order"},
+			},
+			Response: getAllResponse{},
+			Errors:   []int{http.StatusBadRequest},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/{articleId}",
+			Summary:  "Get an article",
+			Response: GetByIDResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/{articleId}/revisions",
+			Summary:  "List article revisions",
+			Response: getRevisionsResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/{articleId}/revisions/diff",
+			Summary: "Diff two article revisions",
+			Query: []openapi.Param{
+				{Name: "from", Type: "integer", Required: true, Description: "Older revision id"},
+				{Name: "to", Type: "integer", Required: true, Description: "Newer revision id"},
+			},
+			Response: diffRevisionsResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/{articleId}/revisions/{revisionId}",
+			Summary:  "Get an article revision",
+			Response: getRevisionResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/{articleId}/relations",
+			Summary:  "List the crop and category pairs of an article",
+			Response: getRelationsResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/",
+			Summary:  "Create an article",
+			Auth:     true,
+			Request:  createRequest{},
+			Response: createResponse{},
+			Status:   http.StatusCreated,
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden, http.StatusConflict},
+		},
+		{
+			Method:   http.MethodPatch,
+			Path:     "/{articleId}",
+			Summary:  "Update an article",
+			Auth:     true,
+			Request:  UpdateRequest{},
+			Response: UpdateResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden, http.StatusConflict},
+		},
+		{
+			Method:   http.MethodDelete,
+			Path:     "/{articleId}",
+			Summary:  "Move an article to the trash",
+			Auth:     true,
+			Response: DeleteResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden},
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/{articleId}/revisions/{revisionId}/restore",
+			Summary:  "Restore an article revision",
+			Auth:     true,
+			Response: restoreRevisionResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound},
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/{articleId}/relations",
+			Summary:  "Link an article to a crop and category",
+			Auth:     true,
+			Request:  model.ArticleRelation{},
+			Response: addRelationResponse{},
+			Status:   http.StatusCreated,
+			Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusConflict},
+		},
+		{
+			Method:   http.MethodDelete,
+			Path:     "/{articleId}/relations/{cropId}/{categoryId}",
+			Summary:  "Unlink an article from a crop and category",
+			Auth:     true,
+			Response: removeRelationResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound},
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/{articleId}/images",
+			Summary:  "Add an uploaded image to an article",
+			Auth:     true,
+			Request:  model.ArticleImageInput{},
+			Response: addImageResponse{},
+			Status:   http.StatusCreated,
+			Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound},
+		},
+		{
+			Method:   http.MethodPut,
+			Path:     "/{articleId}/images/order",
+			Summary:  "Reorder the images of an article",
+			Auth:     true,
+			Request:  reorderImagesRequest{},
+			Response: reorderImagesResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound},
+		},
+		{
+			Method:   http.MethodPatch,
+			Path:     "/{articleId}/images/{imageId}",
+			Summary:  "Update the caption, alt text or attribution of an article image",
+			Auth:     true,
+			Request:  model.ArticleImageDetails{},
+			Response: updateImageResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound},
+		},
+		{
+			Method:   http.MethodDelete,
+			Path:     "/{articleId}/images/{imageId}",
+			Summary:  "Remove an image from an article",
+			Auth:     true,
+			Response: removeImageResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/relations/inconsistent",
+			Summary:  "List article relations whose category isn't linked to the crop",
+			Auth:     true,
+			Response: getInconsistentRelationsResponse{},
+			Errors:   []int{http.StatusForbidden},
+		},
+	}
+}
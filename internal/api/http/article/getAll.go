@@ -2,16 +2,20 @@ package article
 
 import (
 	"errors"
+	"fmt"
 	"github.com/go-chi/render"
 	"github.com/nogavadu/articles-service/internal/domain/model"
 	"github.com/nogavadu/articles-service/internal/lib/api/response"
 	articleService "github.com/nogavadu/articles-service/internal/service/article"
 	"net/http"
 	"strconv"
+	"strings"
 )
 
 type getAllResponse struct {
-	Data []model.Article `json:"data"`
+	Data       []model.Article `json:"data"`
+	NextCursor *string         `json:"next_cursor"`
+	Total      int             `json:"total"`
 }
 
 func (i *Implementation) GetAllHandler() http.HandlerFunc {
@@ -22,19 +26,16 @@ func (i *Implementation) GetAllHandler() http.HandlerFunc {
 			return
 		}
 
-		articles, err := i.articleServ.GetAll(r.Context(), params)
+		list, err := i.articleServ.GetAll(r.Context(), params)
 		if err != nil {
-			if errors.Is(err, articleService.ErrInvalidArguments) {
-				response.Err(w, r, err.Error(), http.StatusBadRequest)
-				return
-			}
-
-			response.Err(w, r, err.Error(), http.StatusInternalServerError)
+			response.Error(w, r, err)
 			return
 		}
 
 		render.JSON(w, r, &getAllResponse{
-			Data: articles,
+			Data:       list.Articles,
+			NextCursor: list.NextCursor,
+			Total:      list.Total,
 		})
 	}
 }
@@ -51,6 +52,15 @@ func articleGetAllQueryParams(r *http.Request) (*model.ArticleGetAllParams, erro
 		params.CropId = &id
 	}
 
+	includeParentCropsStr := r.URL.Query().Get("include_parent_crops")
+	if includeParentCropsStr != "" {
+		includeParentCrops, err := strconv.ParseBool(includeParentCropsStr)
+		if err != nil {
+			return nil, errors.New("invalid include_parent_crops query param")
+		}
+		params.IncludeParentCrops = includeParentCrops
+	}
+
 	categoryIdStr := r.URL.Query().Get("category_id")
 	if categoryIdStr != "" {
 		id, err := strconv.Atoi(categoryIdStr)
@@ -60,10 +70,44 @@ func articleGetAllQueryParams(r *http.Request) (*model.ArticleGetAllParams, erro
 		params.CategoryId = &id
 	}
 
+	includeDescendantsStr := r.URL.Query().Get("include_descendants")
+	if includeDescendantsStr != "" {
+		includeDescendants, err := strconv.ParseBool(includeDescendantsStr)
+		if err != nil {
+			return nil, errors.New("invalid include_descendants query param")
+		}
+		params.IncludeDescendants = includeDescendants
+	}
+
 	status := r.URL.Query().Get("status")
 	if status != "" {
 		params.Status = &status
 	}
 
+	limitStr := r.URL.Query().Get("limit")
+	if limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 || limit > articleService.MaxPageLimit {
+			return nil, fmt.Errorf("limit must be between 1 and %d", articleService.MaxPageLimit)
+		}
+		params.Limit = limit
+	}
+
+	cursor := r.URL.Query().Get("cursor")
+	if cursor != "" {
+		params.Cursor = &cursor
+	}
+
+	q := strings.TrimSpace(r.URL.Query().Get("q"))
+	if q != "" {
+		params.Query = &q
+	}
+
+	// sort=title sorts ascending, sort=-title descending, the service validates it and picks the default.
+	if sort := r.URL.Query().Get("sort"); sort != "" {
+		params.Sort = strings.TrimPrefix(sort, "-")
+		params.Desc = strings.HasPrefix(sort, "-")
+	}
+
 	return params, nil
 }
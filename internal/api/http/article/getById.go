@@ -1,12 +1,10 @@
 package article
 
 import (
-	"errors"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
 	"github.com/nogavadu/articles-service/internal/domain/model"
 	"github.com/nogavadu/articles-service/internal/lib/api/response"
-	articleServ "github.com/nogavadu/articles-service/internal/service/article"
 	"net/http"
 	"strconv"
 )
@@ -30,11 +28,7 @@ func (i *Implementation) GetByIDHandler() http.HandlerFunc {
 
 		article, err := i.articleServ.GetById(r.Context(), id)
 		if err != nil {
-			if errors.Is(err, articleServ.ErrInvalidArguments) {
-				response.Err(w, r, err.Error(), http.StatusBadRequest)
-				return
-			}
-			response.Err(w, r, err.Error(), http.StatusInternalServerError)
+			response.Error(w, r, err)
 			return
 		}
 
@@ -0,0 +1,26 @@
+package article
+
+import (
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+)
+
+type getInconsistentRelationsResponse struct {
+	Data []model.InconsistentArticleRelation `json:"data"`
+}
+
+func (i *Implementation) GetInconsistentRelationsHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		relations, err := i.articleServ.GetInconsistentRelations(r.Context())
+		if err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &getInconsistentRelationsResponse{
+			Data: relations,
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package article
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type getRelationsResponse struct {
+	Data []model.ArticleRelation `json:"data"`
+}
+
+func (i *Implementation) GetRelationsHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		idStr := chi.URLParam(r, "articleId")
+		if idStr == "" {
+			response.Err(w, r, "article id is required", http.StatusBadRequest)
+			return
+		}
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			response.Err(w, r, "invalid article id", http.StatusBadRequest)
+			return
+		}
+
+		relations, err := i.articleServ.GetRelations(r.Context(), id)
+		if err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &getRelationsResponse{
+			Data: relations,
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package article
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type getRevisionResponse struct {
+	*model.ArticleRevision
+}
+
+func (i *Implementation) GetRevisionHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		idStr := chi.URLParam(r, "articleId")
+		if idStr == "" {
+			response.Err(w, r, "article id is required", http.StatusBadRequest)
+			return
+		}
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			response.Err(w, r, "invalid article id", http.StatusBadRequest)
+			return
+		}
+
+		revisionIdStr := chi.URLParam(r, "revisionId")
+		if revisionIdStr == "" {
+			response.Err(w, r, "revision id is required", http.StatusBadRequest)
+			return
+		}
+		revisionId, err := strconv.Atoi(revisionIdStr)
+		if err != nil {
+			response.Err(w, r, "invalid revision id", http.StatusBadRequest)
+			return
+		}
+
+		revision, err := i.articleServ.GetRevision(r.Context(), id, revisionId)
+		if err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &getRevisionResponse{
+			ArticleRevision: revision,
+		})
+	}
+}
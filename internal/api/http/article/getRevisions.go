@@ -0,0 +1,39 @@
+package article
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type getRevisionsResponse struct {
+	Data []model.ArticleRevision `json:"data"`
+}
+
+func (i *Implementation) GetRevisionsHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		idStr := chi.URLParam(r, "articleId")
+		if idStr == "" {
+			response.Err(w, r, "article id is required", http.StatusBadRequest)
+			return
+		}
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			response.Err(w, r, "invalid article id", http.StatusBadRequest)
+			return
+		}
+
+		revisions, err := i.articleServ.GetRevisions(r.Context(), id)
+		if err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &getRevisionsResponse{
+			Data: revisions,
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package article
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type removeImageResponse struct {
+	Status string `json:"status"`
+}
+
+func (i *Implementation) RemoveImageHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.Atoi(chi.URLParam(r, "articleId"))
+		if err != nil {
+			response.Err(w, r, "invalid article id", http.StatusBadRequest)
+			return
+		}
+		imageId, err := strconv.Atoi(chi.URLParam(r, "imageId"))
+		if err != nil {
+			response.Err(w, r, "invalid image id", http.StatusBadRequest)
+			return
+		}
+
+		if err = i.articleServ.RemoveImage(r.Context(), id, imageId); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &removeImageResponse{
+			Status: "ok",
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package article
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type removeRelationResponse struct {
+	Status string `json:"status"`
+}
+
+func (i *Implementation) RemoveRelationHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		idStr := chi.URLParam(r, "articleId")
+		if idStr == "" {
+			response.Err(w, r, "article id is required", http.StatusBadRequest)
+			return
+		}
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			response.Err(w, r, "invalid article id", http.StatusBadRequest)
+			return
+		}
+
+		cropId, err := strconv.Atoi(chi.URLParam(r, "cropId"))
+		if err != nil {
+			response.Err(w, r, "invalid crop id", http.StatusBadRequest)
+			return
+		}
+		categoryId, err := strconv.Atoi(chi.URLParam(r, "categoryId"))
+		if err != nil {
+			response.Err(w, r, "invalid category id", http.StatusBadRequest)
+			return
+		}
+
+		relation := &model.ArticleRelation{
+			CropId:     cropId,
+			CategoryId: categoryId,
+		}
+		if err = i.articleServ.RemoveRelation(r.Context(), id, relation); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &removeRelationResponse{
+			Status: "ok",
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package article
+
+import (
+	"encoding/json"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/lib/api/request"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type reorderImagesRequest struct {
+	ImageIds []int `json:"image_ids" validate:"required,unique,dive,gt=0"`
+}
+
+type reorderImagesResponse struct {
+	Status string `json:"status"`
+}
+
+func (i *Implementation) ReorderImagesHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.Atoi(chi.URLParam(r, "articleId"))
+		if err != nil {
+			response.Err(w, r, "invalid article id", http.StatusBadRequest)
+			return
+		}
+
+		var reqData reorderImagesRequest
+		if err = json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+			response.Err(w, r, "invalid request body format", http.StatusBadRequest)
+			return
+		}
+		if err = request.Validate(&reqData); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		if err = i.articleServ.ReorderImages(r.Context(), id, reqData.ImageIds); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &reorderImagesResponse{
+			Status: "ok",
+		})
+	}
+}
@@ -50,7 +50,7 @@ func (i *Implementation) UpdateHandler() http.HandlerFunc {
 		}
 
 		if err = i.articleServ.Update(r.Context(), id, &reqData.ArticleUpdateInput); err != nil {
-			response.Err(w, r, err.Error(), http.StatusInternalServerError)
+			response.Error(w, r, err)
 			return
 		}
 
@@ -0,0 +1,56 @@
+package article
+
+import (
+	"encoding/json"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/request"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type updateImageResponse struct {
+	Status string `json:"status"`
+}
+
+func (i *Implementation) UpdateImageHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.Atoi(chi.URLParam(r, "articleId"))
+		if err != nil {
+			response.Err(w, r, "invalid article id", http.StatusBadRequest)
+			return
+		}
+		imageId, err := strconv.Atoi(chi.URLParam(r, "imageId"))
+		if err != nil {
+			response.Err(w, r, "invalid image id", http.StatusBadRequest)
+			return
+		}
+
+		var reqData model.ArticleImageDetails
+		if err = json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+			response.Err(w, r, "invalid request body format", http.StatusBadRequest)
+			return
+		}
+
+		isEmpty, err := request.IsStructEmpty(reqData)
+		if err != nil {
+			response.Err(w, r, "invalid request body type", http.StatusBadRequest)
+			return
+		}
+		if isEmpty {
+			response.Err(w, r, "empty request body", http.StatusBadRequest)
+			return
+		}
+
+		if err = i.articleServ.UpdateImage(r.Context(), id, imageId, &reqData); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &updateImageResponse{
+			Status: "ok",
+		})
+	}
+}
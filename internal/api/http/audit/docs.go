@@ -0,0 +1,28 @@
+package audit
+
+import (
+	"github.com/nogavadu/articles-service/internal/lib/api/openapi"
+	"net/http"
+)
+
+// Operations documents the audit routes relative to their mount point.
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method:  http.MethodGet,
+			Path:    "/",
+			Summary: "List audit log entries",
+			Auth:    true,
+			Query: []openapi.Param{
+				{Name: "entity_type", Description: "crop, category, article, pest or calendar_entry"},
+				{Name: "entity_id", Type: "integer"},
+				{Name: "actor", Type: "integer", Description: "Id of the user who made the change"},
+				{Name: "from", Format: "date-time", Description: "RFC3339 lower bound of created_at"},
+				{Name: "to", Format: "date-time", Description: "RFC3339 upper bound of created_at"},
+				{Name: "limit", Type: "integer"},
+			},
+			Response: getAllResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusForbidden},
+		},
+	}
+}
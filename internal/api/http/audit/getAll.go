@@ -0,0 +1,98 @@
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	auditServ "github.com/nogavadu/articles-service/internal/service/audit"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type getAllResponse struct {
+	Data []model.AuditEntry `json:"data"`
+}
+
+func (i *Implementation) GetAllHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params, err := auditGetAllQueryParams(r)
+		if err != nil {
+			response.Err(w, r, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		entries, err := i.auditServ.GetAll(r.Context(), params)
+		if err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &getAllResponse{
+			Data: entries,
+		})
+	}
+}
+
+func auditGetAllQueryParams(r *http.Request) (*model.AuditGetAllParams, error) {
+	params := &model.AuditGetAllParams{}
+
+	entityType := r.URL.Query().Get("entity_type")
+	if entityType != "" {
+		switch entityType {
+		case model.EntityCrop, model.EntityCategory, model.EntityArticle, model.EntityPest, model.EntityCalendarEntry:
+			params.EntityType = &entityType
+		default:
+			return nil, errors.New("invalid entity_type query param")
+		}
+	}
+
+	entityIdStr := r.URL.Query().Get("entity_id")
+	if entityIdStr != "" {
+		id, err := strconv.Atoi(entityIdStr)
+		if err != nil {
+			return nil, errors.New("invalid entity_id query param")
+		}
+		params.EntityId = &id
+	}
+
+	actorStr := r.URL.Query().Get("actor")
+	if actorStr != "" {
+		id, err := strconv.Atoi(actorStr)
+		if err != nil {
+			return nil, errors.New("invalid actor query param")
+		}
+		params.Actor = &id
+	}
+
+	fromStr := r.URL.Query().Get("from")
+	if fromStr != "" {
+		from, err := time.Parse(time.RFC3339, fromStr)
+		if err != nil {
+			return nil, errors.New("from query param must be an RFC 3339 timestamp")
+		}
+		params.From = &from
+	}
+
+	toStr := r.URL.Query().Get("to")
+	if toStr != "" {
+		to, err := time.Parse(time.RFC3339, toStr)
+		if err != nil {
+			return nil, errors.New("to query param must be an RFC 3339 timestamp")
+		}
+		params.To = &to
+	}
+
+	limitStr := r.URL.Query().Get("limit")
+	if limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 || limit > auditServ.MaxPageLimit {
+			return nil, fmt.Errorf("limit must be between 1 and %d", auditServ.MaxPageLimit)
+		}
+		params.Limit = limit
+	}
+
+	return params, nil
+}
@@ -0,0 +1,15 @@
+package audit
+
+import (
+	"github.com/nogavadu/articles-service/internal/service"
+)
+
+type Implementation struct {
+	auditServ service.AuditService
+}
+
+func New(auditService service.AuditService) *Implementation {
+	return &Implementation{
+		auditServ: auditService,
+	}
+}
@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"github.com/nogavadu/articles-service/internal/lib/api/openapi"
+	"net/http"
+)
+
+// Operations documents the auth routes relative to their mount point.
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method:   http.MethodPost,
+			Path:     "/register",
+			Summary:  "Register a user",
+			Request:  registerRequest{},
+			Response: registerResponse{},
+			Errors:   []int{http.StatusBadRequest},
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/login",
+			Summary:  "Log in and get a refresh token",
+			Request:  loginRequest{},
+			Response: loginResponse{},
+			Errors:   []int{http.StatusBadRequest},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/refreshToken",
+			Summary:  "Get a new refresh token",
+			Auth:     true,
+			Response: getRefreshTokenResponse{},
+		},
+	}
+}
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
 	"net/http"
 )
 
@@ -13,9 +14,8 @@ func (i *Implementation) GetRefreshTokenHandler() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		token, err := i.authServ.GetRefreshToken(r.Context())
 		if err != nil {
-			render.JSON(w, r, &getRefreshTokenResponse{
-				Token: "",
-			})
+			response.Error(w, r, err)
+			return
 		}
 
 		render.JSON(w, r, &getRefreshTokenResponse{
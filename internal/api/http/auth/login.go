@@ -3,8 +3,8 @@ package auth
 import (
 	"encoding/json"
 	"github.com/go-chi/render"
-	"github.com/go-playground/validator/v10"
 	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/request"
 	"github.com/nogavadu/articles-service/internal/lib/api/response"
 	"net/http"
 )
@@ -24,14 +24,14 @@ func (i *Implementation) LoginHandler() http.HandlerFunc {
 			response.Err(w, r, "invalid request", http.StatusBadRequest)
 			return
 		}
-		if err := validator.New().Struct(reqData); err != nil {
-			response.Err(w, r, "invalid email/ password", http.StatusBadRequest)
+		if err := request.Validate(reqData); err != nil {
+			response.Error(w, r, err)
 			return
 		}
 
 		token, err := i.authServ.Login(r.Context(), &reqData.UserAuthData)
 		if err != nil {
-			response.Err(w, r, err.Error(), http.StatusBadRequest)
+			response.Error(w, r, err)
 			return
 		}
 
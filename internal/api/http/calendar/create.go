@@ -0,0 +1,47 @@
+package calendar
+
+import (
+	"encoding/json"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/request"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type createResponse struct {
+	Id int `json:"id"`
+}
+
+func (i *Implementation) CreateHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cropId, err := strconv.Atoi(chi.URLParam(r, "cropId"))
+		if err != nil {
+			response.Err(w, r, "invalid crop id", http.StatusBadRequest)
+			return
+		}
+
+		var reqData model.CalendarEntryInfo
+		if err = json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+			response.Err(w, r, "invalid request body format", http.StatusBadRequest)
+			return
+		}
+		if err = request.Validate(&reqData); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		id, err := i.calendarServ.Create(r.Context(), cropId, &reqData)
+		if err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, &createResponse{
+			Id: id,
+		})
+	}
+}
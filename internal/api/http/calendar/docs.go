@@ -0,0 +1,59 @@
+package calendar
+
+import (
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/openapi"
+	"net/http"
+)
+
+// Operations documents the calendar routes relative to /api, entries are nested under their crop.
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method:  http.MethodGet,
+			Path:    "/calendar",
+			Summary: "List the activities of every published crop in a climate zone during a month",
+			Query: []openapi.Param{
+				{Name: "zone", Required: true, Description: "Hardiness or climate zone, e.g. 7b"},
+				{Name: "month", Type: "integer", Required: true, Description: "Month number from 1 to 12"},
+				{Name: "week", Type: "integer", Description: "ISO week from 1 to 53, entries with weeks must cover it too"},
+			},
+			Response: getByZoneResponse{},
+			Errors:   []int{http.StatusBadRequest},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/crops/{cropId}/calendar",
+			Summary:  "List the calendar entries of a crop",
+			Response: getAllResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/crops/{cropId}/calendar",
+			Summary:  "Add a calendar entry to a crop",
+			Auth:     true,
+			Request:  model.CalendarEntryInfo{},
+			Response: createResponse{},
+			Status:   http.StatusCreated,
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden},
+		},
+		{
+			Method:   http.MethodPatch,
+			Path:     "/crops/{cropId}/calendar/{entryId}",
+			Summary:  "Update a calendar entry",
+			Auth:     true,
+			Request:  model.UpdateCalendarEntryInput{},
+			Response: updateResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden},
+		},
+		{
+			Method:   http.MethodDelete,
+			Path:     "/crops/{cropId}/calendar/{entryId}",
+			Summary:  "Delete a calendar entry",
+			Auth:     true,
+			Response: deleteResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden},
+		},
+	}
+}
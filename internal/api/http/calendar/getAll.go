@@ -0,0 +1,34 @@
+package calendar
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type getAllResponse struct {
+	Data []model.CalendarEntry `json:"data"`
+}
+
+func (i *Implementation) GetAllHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cropId, err := strconv.Atoi(chi.URLParam(r, "cropId"))
+		if err != nil {
+			response.Err(w, r, "invalid crop id", http.StatusBadRequest)
+			return
+		}
+
+		entries, err := i.calendarServ.GetAll(r.Context(), cropId)
+		if err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &getAllResponse{
+			Data: entries,
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package calendar
+
+import (
+	"errors"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type getByZoneResponse struct {
+	Data []model.CalendarZoneEntry `json:"data"`
+}
+
+func (i *Implementation) GetByZoneHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params, err := calendarZoneQueryParams(r)
+		if err != nil {
+			response.Err(w, r, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		entries, err := i.calendarServ.GetByZone(r.Context(), params)
+		if err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &getByZoneResponse{
+			Data: entries,
+		})
+	}
+}
+
+func calendarZoneQueryParams(r *http.Request) (*model.CalendarZoneParams, error) {
+	zone := r.URL.Query().Get("zone")
+	if zone == "" {
+		return nil, errors.New("zone query param is required")
+	}
+
+	month, err := strconv.Atoi(r.URL.Query().Get("month"))
+	if err != nil || month < 1 || month > 12 {
+		return nil, errors.New("month query param must be between 1 and 12")
+	}
+
+	params := &model.CalendarZoneParams{
+		Zone:  zone,
+		Month: month,
+	}
+
+	if weekStr := r.URL.Query().Get("week"); weekStr != "" {
+		week, err := strconv.Atoi(weekStr)
+		if err != nil || week < 1 || week > 53 {
+			return nil, errors.New("week query param must be between 1 and 53")
+		}
+		params.Week = &week
+	}
+
+	return params, nil
+}
@@ -0,0 +1,15 @@
+package calendar
+
+import (
+	"github.com/nogavadu/articles-service/internal/service"
+)
+
+type Implementation struct {
+	calendarServ service.CalendarService
+}
+
+func New(calendarService service.CalendarService) *Implementation {
+	return &Implementation{
+		calendarServ: calendarService,
+	}
+}
@@ -0,0 +1,60 @@
+package calendar
+
+import (
+	"encoding/json"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/request"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type updateResponse struct {
+	Status string `json:"status"`
+}
+
+func (i *Implementation) UpdateHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cropId, err := strconv.Atoi(chi.URLParam(r, "cropId"))
+		if err != nil {
+			response.Err(w, r, "invalid crop id", http.StatusBadRequest)
+			return
+		}
+		id, err := strconv.Atoi(chi.URLParam(r, "entryId"))
+		if err != nil {
+			response.Err(w, r, "invalid calendar entry id", http.StatusBadRequest)
+			return
+		}
+
+		var reqData model.UpdateCalendarEntryInput
+		if err = json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+			response.Err(w, r, "invalid request body format", http.StatusBadRequest)
+			return
+		}
+
+		isEmpty, err := request.IsStructEmpty(reqData)
+		if err != nil {
+			response.Err(w, r, "invalid request body type", http.StatusBadRequest)
+			return
+		}
+		if isEmpty {
+			response.Err(w, r, "empty request body", http.StatusBadRequest)
+			return
+		}
+		if err = request.Validate(&reqData); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		if err = i.calendarServ.Update(r.Context(), cropId, id, &reqData); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &updateResponse{
+			Status: "ok",
+		})
+	}
+}
@@ -4,16 +4,15 @@ import (
 	"encoding/json"
 	"errors"
 	"github.com/go-chi/render"
-	"github.com/go-playground/validator/v10"
 	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/request"
 	"github.com/nogavadu/articles-service/internal/lib/api/response"
-	categoryServ "github.com/nogavadu/articles-service/internal/service/category"
 	"net/http"
 	"strconv"
 )
 
 type createRequest struct {
-	UserId   int                `json:"user_id" validate:"required"`
+	UserId   *int               `json:"user_id,omitempty"`
 	Category model.CategoryInfo `json:"category" validate:"required"`
 }
 
@@ -34,23 +33,18 @@ func (i *Implementation) CreateHandler() http.HandlerFunc {
 			response.Err(w, r, "invalid request body format", http.StatusBadRequest)
 			return
 		}
-		if err = validator.New().Struct(&reqData); err != nil {
-			response.Err(w, r, "invalid arguments", http.StatusBadRequest)
+		if err = request.Validate(&reqData); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+		if err = request.CheckUserId(r, reqData.UserId); err != nil {
+			response.Err(w, r, err.Error(), http.StatusBadRequest)
 			return
 		}
 
-		id, err := i.categoryServ.Create(r.Context(), reqData.UserId, &reqData.Category, params)
+		id, err := i.categoryServ.Create(r.Context(), &reqData.Category, params)
 		if err != nil {
-			if errors.Is(err, categoryServ.ErrAlreadyExists) {
-				response.Err(w, r, err.Error(), http.StatusBadRequest)
-				return
-			}
-			if errors.Is(err, categoryServ.ErrAccessDenied) {
-				response.Err(w, r, err.Error(), http.StatusForbidden)
-				return
-			}
-
-			response.Err(w, r, "internal server error", http.StatusInternalServerError)
+			response.Error(w, r, err)
 			return
 		}
 
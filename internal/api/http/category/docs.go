@@ -0,0 +1,70 @@
+package category
+
+import (
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/openapi"
+	"net/http"
+)
+
+// Operations documents the category routes relative to their mount point.
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method:  http.MethodGet,
+			Path:    "/",
+			Summary: "List categories as a tree of top level categories and their children",
+			Query: []openapi.Param{
+				{Name: "crop_id", Type: "integer", Description: "Only categories linked to this crop"},
+				{Name: "status", Description: "Only categories with this status, review by default"},
+			},
+			Response: getAllResponse{},
+			Errors:   []int{http.StatusBadRequest},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/{categoryId}",
+			Summary:  "Get a category",
+			Response: getByIdResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/",
+			Summary: "Create a category",
+			Auth:    true,
+			Query: []openapi.Param{
+				{Name: "crop_id", Type: "integer", Description: "Crop to link the new category to"},
+			},
+			Request:  createRequest{},
+			Response: createResponse{},
+			Status:   http.StatusCreated,
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden, http.StatusConflict},
+		},
+		{
+			Method:   http.MethodPatch,
+			Path:     "/{categoryId}",
+			Summary:  "Update a category",
+			Auth:     true,
+			Request:  UpdateRequest{},
+			Response: updateResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden, http.StatusConflict},
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/{categoryId}/move",
+			Summary:  "Move a category with its subcategories under another parent",
+			Auth:     true,
+			Request:  model.CategoryMoveInput{},
+			Response: moveResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden},
+		},
+		{
+			Method:   http.MethodDelete,
+			Path:     "/{categoryId}",
+			Summary:  "Move a category to the trash",
+			Auth:     true,
+			Response: DeleteResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden},
+		},
+	}
+}
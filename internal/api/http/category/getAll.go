@@ -23,7 +23,7 @@ func (i *Implementation) GetAllHandler() http.HandlerFunc {
 
 		categories, err := i.categoryServ.GetAll(r.Context(), params)
 		if err != nil {
-			response.Err(w, r, err.Error(), http.StatusInternalServerError)
+			response.Error(w, r, err)
 			return
 		}
 
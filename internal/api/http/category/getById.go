@@ -1,12 +1,10 @@
 package category
 
 import (
-	"errors"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
 	"github.com/nogavadu/articles-service/internal/domain/model"
 	"github.com/nogavadu/articles-service/internal/lib/api/response"
-	categoryServ "github.com/nogavadu/articles-service/internal/service/category"
 	"net/http"
 	"strconv"
 )
@@ -30,12 +28,7 @@ func (i *Implementation) GetByIdHandler() http.HandlerFunc {
 
 		category, err := i.categoryServ.GetById(r.Context(), categoryId)
 		if err != nil {
-			if errors.Is(err, categoryServ.ErrNotFound) || errors.Is(err, categoryServ.ErrInvalidArguments) {
-				response.Err(w, r, err.Error(), http.StatusBadRequest)
-				return
-			}
-
-			response.Err(w, r, err.Error(), http.StatusInternalServerError)
+			response.Error(w, r, err)
 			return
 		}
 
@@ -0,0 +1,50 @@
+package category
+
+import (
+	"encoding/json"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/request"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type moveResponse struct {
+	Status string `json:"status"`
+}
+
+func (i *Implementation) MoveHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		idStr := chi.URLParam(r, "categoryId")
+		if idStr == "" {
+			response.Err(w, r, "category id is required", http.StatusBadRequest)
+			return
+		}
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			response.Err(w, r, "category id is invalid", http.StatusBadRequest)
+			return
+		}
+
+		var reqData model.CategoryMoveInput
+		if err = json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+			response.Err(w, r, "invalid request body format", http.StatusBadRequest)
+			return
+		}
+		if err = request.Validate(&reqData); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		if err = i.categoryServ.Move(r.Context(), id, &reqData); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &moveResponse{
+			Status: "ok",
+		})
+	}
+}
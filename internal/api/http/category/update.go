@@ -50,7 +50,7 @@ func (i *Implementation) UpdateHandler() http.HandlerFunc {
 		}
 
 		if err = i.categoryServ.Update(r.Context(), id, &reqData.UpdateCategoryInput); err != nil {
-			response.Err(w, r, err.Error(), http.StatusBadRequest)
+			response.Error(w, r, err)
 			return
 		}
 
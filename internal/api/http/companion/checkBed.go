@@ -0,0 +1,38 @@
+package companion
+
+import (
+	"encoding/json"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/request"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+)
+
+type checkBedResponse struct {
+	Conflicts []model.BedConflict `json:"conflicts"`
+}
+
+func (i *Implementation) CheckBedHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var reqData model.BedCheckInput
+		if err := json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+			response.Err(w, r, "invalid request body format", http.StatusBadRequest)
+			return
+		}
+		if err := request.Validate(&reqData); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		conflicts, err := i.companionServ.CheckBed(r.Context(), &reqData)
+		if err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &checkBedResponse{
+			Conflicts: conflicts,
+		})
+	}
+}
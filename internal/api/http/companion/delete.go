@@ -0,0 +1,37 @@
+package companion
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type deleteResponse struct {
+	Status string `json:"status"`
+}
+
+func (i *Implementation) DeleteHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cropId, err := strconv.Atoi(chi.URLParam(r, "cropId"))
+		if err != nil {
+			response.Err(w, r, "invalid crop id", http.StatusBadRequest)
+			return
+		}
+		companionId, err := strconv.Atoi(chi.URLParam(r, "companionId"))
+		if err != nil {
+			response.Err(w, r, "invalid companion id", http.StatusBadRequest)
+			return
+		}
+
+		if err = i.companionServ.Delete(r.Context(), cropId, companionId); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &deleteResponse{
+			Status: "ok",
+		})
+	}
+}
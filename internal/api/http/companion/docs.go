@@ -0,0 +1,45 @@
+package companion
+
+import (
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/openapi"
+	"net/http"
+)
+
+// Operations documents the companion routes relative to /api/crops, a pair is listed under both crops.
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method:   http.MethodPost,
+			Path:     "/check-bed",
+			Summary:  "List the antagonist pairs among crops planned in the same bed",
+			Request:  model.BedCheckInput{},
+			Response: checkBedResponse{},
+			Errors:   []int{http.StatusBadRequest},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/{cropId}/companions",
+			Summary:  "List the companions and antagonists of a crop",
+			Response: getAllResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+		},
+		{
+			Method:   http.MethodPut,
+			Path:     "/{cropId}/companions/{companionId}",
+			Summary:  "Create or replace the relation between two crops",
+			Auth:     true,
+			Request:  model.CropCompanionInfo{},
+			Response: setResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden},
+		},
+		{
+			Method:   http.MethodDelete,
+			Path:     "/{cropId}/companions/{companionId}",
+			Summary:  "Remove the relation between two crops",
+			Auth:     true,
+			Response: deleteResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden},
+		},
+	}
+}
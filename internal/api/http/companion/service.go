@@ -0,0 +1,15 @@
+package companion
+
+import (
+	"github.com/nogavadu/articles-service/internal/service"
+)
+
+type Implementation struct {
+	companionServ service.CompanionService
+}
+
+func New(companionService service.CompanionService) *Implementation {
+	return &Implementation{
+		companionServ: companionService,
+	}
+}
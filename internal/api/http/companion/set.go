@@ -0,0 +1,50 @@
+package companion
+
+import (
+	"encoding/json"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/request"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type setResponse struct {
+	Status string `json:"status"`
+}
+
+func (i *Implementation) SetHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cropId, err := strconv.Atoi(chi.URLParam(r, "cropId"))
+		if err != nil {
+			response.Err(w, r, "invalid crop id", http.StatusBadRequest)
+			return
+		}
+		companionId, err := strconv.Atoi(chi.URLParam(r, "companionId"))
+		if err != nil {
+			response.Err(w, r, "invalid companion id", http.StatusBadRequest)
+			return
+		}
+
+		var reqData model.CropCompanionInfo
+		if err = json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+			response.Err(w, r, "invalid request body format", http.StatusBadRequest)
+			return
+		}
+		if err = request.Validate(&reqData); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		if err = i.companionServ.Set(r.Context(), cropId, companionId, &reqData); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &setResponse{
+			Status: "ok",
+		})
+	}
+}
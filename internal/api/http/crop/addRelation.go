@@ -1,11 +1,9 @@
 package crop
 
 import (
-	"errors"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
 	"github.com/nogavadu/articles-service/internal/lib/api/response"
-	"github.com/nogavadu/articles-service/internal/service/crop"
 	"net/http"
 	"strconv"
 )
@@ -38,14 +36,7 @@ func (i *Implementation) AddRelationHandler() http.HandlerFunc {
 		}
 
 		if err = i.cropServ.AddRelation(r.Context(), cropId, categoryId); err != nil {
-			if errors.Is(err, crop.ErrAccessDenied) {
-				render.JSON(w, r, &updateResponse{
-					Status: "AccessDenied",
-				})
-				return
-			}
-
-			response.Err(w, r, err.Error(), http.StatusBadRequest)
+			response.Error(w, r, err)
 			return
 		}
 
@@ -0,0 +1,82 @@
+package crop
+
+import (
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/openapi"
+	"net/http"
+)
+
+// Operations documents the crop routes relative to their mount point.
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method:  http.MethodGet,
+			Path:    "/",
+			Summary: "List crops",
+			Query: []openapi.Param{
+				{Name: "status", Description: "Only crops with this status, published by default"},
+			},
+			Response: getAllResponse{},
+			Errors:   []int{http.StatusBadRequest},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/{cropId}",
+			Summary:  "Get a crop",
+			Response: getByIdResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/",
+			Summary:  "Create a crop",
+			Auth:     true,
+			Request:  createRequest{},
+			Response: createResponse{},
+			Status:   http.StatusCreated,
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden, http.StatusConflict},
+		},
+		{
+			Method:   http.MethodPatch,
+			Path:     "/{cropId}",
+			Summary:  "Update a crop",
+			Auth:     true,
+			Request:  updateRequest{},
+			Response: updateResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden, http.StatusConflict},
+		},
+		{
+			Method:   http.MethodPut,
+			Path:     "/{cropId}/parent",
+			Summary:  "Make a crop a variety of another crop, a null parent_id makes it standalone",
+			Auth:     true,
+			Request:  model.CropParentInput{},
+			Response: setParentResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden},
+		},
+		{
+			Method:   http.MethodDelete,
+			Path:     "/{cropId}",
+			Summary:  "Move a crop to the trash",
+			Auth:     true,
+			Response: DeleteResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden},
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/{cropId}/{categoryId}",
+			Summary:  "Link a category to a crop",
+			Auth:     true,
+			Response: addRelationResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden},
+		},
+		{
+			Method:   http.MethodDelete,
+			Path:     "/{cropId}/{categoryId}",
+			Summary:  "Unlink a category from a crop",
+			Auth:     true,
+			Response: removeRelationResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden, http.StatusConflict},
+		},
+	}
+}
@@ -3,6 +3,7 @@ package crop
 import (
 	"github.com/go-chi/render"
 	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
 	"net/http"
 )
 
@@ -15,7 +16,7 @@ func (i *Implementation) GetAllHandler() http.HandlerFunc {
 		params := cropGetAllParams(r)
 		crops, err := i.cropServ.GetAll(r.Context(), params)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			response.Error(w, r, err)
 			return
 		}
 
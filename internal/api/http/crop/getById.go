@@ -28,7 +28,8 @@ func (i *Implementation) GetByIdHandler() http.HandlerFunc {
 
 		crop, err := i.cropServ.GetById(r.Context(), cropId)
 		if err != nil {
-			response.Err(w, r, err.Error(), http.StatusInternalServerError)
+			response.Error(w, r, err)
+			return
 		}
 
 		render.JSON(w, r, &getByIdResponse{
@@ -0,0 +1,45 @@
+package crop
+
+import (
+	"encoding/json"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type setParentResponse struct {
+	Status string `json:"status"`
+}
+
+func (i *Implementation) SetParentHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cropIdStr := chi.URLParam(r, "cropId")
+		if cropIdStr == "" {
+			response.Err(w, r, "crop id is required", http.StatusBadRequest)
+			return
+		}
+		cropId, err := strconv.Atoi(cropIdStr)
+		if err != nil {
+			response.Err(w, r, "invalid crop id", http.StatusBadRequest)
+			return
+		}
+
+		var reqData model.CropParentInput
+		if err = json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+			response.Err(w, r, "invalid request body format", http.StatusBadRequest)
+			return
+		}
+
+		if err = i.cropServ.SetParent(r.Context(), cropId, &reqData); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &setParentResponse{
+			Status: "ok",
+		})
+	}
+}
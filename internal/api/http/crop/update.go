@@ -2,14 +2,12 @@ package crop
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
 	"github.com/nogavadu/articles-service/internal/domain/model"
 	"github.com/nogavadu/articles-service/internal/lib/api/request"
 	"github.com/nogavadu/articles-service/internal/lib/api/response"
-	"github.com/nogavadu/articles-service/internal/service/crop"
 	"net/http"
 	"strconv"
 )
@@ -52,14 +50,7 @@ func (i *Implementation) UpdateHandler() http.HandlerFunc {
 		}
 
 		if err = i.cropServ.Update(r.Context(), id, &reqData.UpdateCropInput); err != nil {
-			if errors.Is(err, crop.ErrAccessDenied) {
-				render.JSON(w, r, &updateResponse{
-					Status: "AccessDenied",
-				})
-				return
-			}
-
-			response.Err(w, r, err.Error(), http.StatusInternalServerError)
+			response.Error(w, r, err)
 			return
 		}
 
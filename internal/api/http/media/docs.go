@@ -0,0 +1,36 @@
+package media
+
+import (
+	"github.com/nogavadu/articles-service/internal/lib/api/openapi"
+	"net/http"
+)
+
+// Operations documents the media routes relative to their mount point.
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method:   http.MethodPost,
+			Path:     "/",
+			Summary:  "Upload an image, uploading the same content again returns the stored media",
+			Auth:     true,
+			Upload:   uploadField,
+			Response: uploadResponse{},
+			Status:   http.StatusCreated,
+			Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusRequestEntityTooLarge},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/{mediaId}",
+			Summary:  "Get the metadata of an uploaded image",
+			Response: getByIdResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/files/{key}",
+			Summary: "Download an uploaded image",
+			Binary:  true,
+			Errors:  []int{http.StatusNotFound},
+		},
+	}
+}
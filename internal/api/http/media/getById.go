@@ -0,0 +1,34 @@
+package media
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type getByIdResponse struct {
+	model.Media
+}
+
+func (i *Implementation) GetByIdHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		mediaId, err := strconv.Atoi(chi.URLParam(r, "mediaId"))
+		if err != nil {
+			response.Err(w, r, "invalid media id", http.StatusBadRequest)
+			return
+		}
+
+		media, err := i.mediaServ.GetById(r.Context(), mediaId)
+		if err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &getByIdResponse{
+			Media: *media,
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package media
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// ServeHandler streams a stored original or variant, keys are derived from content checksums so the response never changes.
+func (i *Implementation) ServeHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		mediaFile, file, err := i.mediaServ.Open(r.Context(), chi.URLParam(r, "key"))
+		if err != nil {
+			response.Error(w, r, err)
+			return
+		}
+		defer file.Close()
+
+		etag := `"` + mediaFile.Key + `"`
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("Content-Type", mediaFile.ContentType)
+		w.Header().Set("Content-Length", strconv.FormatInt(mediaFile.Size, 10))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		_, _ = io.Copy(w, file)
+	}
+}
@@ -0,0 +1,17 @@
+package media
+
+import (
+	"github.com/nogavadu/articles-service/internal/service"
+)
+
+type Implementation struct {
+	mediaServ     service.MediaService
+	maxUploadSize int64
+}
+
+func New(mediaService service.MediaService, maxUploadSize int64) *Implementation {
+	return &Implementation{
+		mediaServ:     mediaService,
+		maxUploadSize: maxUploadSize,
+	}
+}
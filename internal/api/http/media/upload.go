@@ -0,0 +1,67 @@
+package media
+
+import (
+	"errors"
+	"fmt"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"io"
+	"net/http"
+)
+
+const uploadField = "file"
+
+// formOverhead leaves room for the multipart boundaries, part headers and small fields around the file.
+const formOverhead = 64 << 10
+
+type uploadResponse struct {
+	model.Media
+}
+
+// UploadHandler streams the "file" part of a multipart form to the service without buffering the whole form.
+func (i *Implementation) UploadHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r.Body = http.MaxBytesReader(w, r.Body, i.maxUploadSize+formOverhead)
+
+		mr, err := r.MultipartReader()
+		if err != nil {
+			response.Err(w, r, fmt.Sprintf("invalid request body: %s", err), http.StatusBadRequest)
+			return
+		}
+
+		for {
+			part, err := mr.NextPart()
+			if errors.Is(err, io.EOF) {
+				response.Err(w, r, fmt.Sprintf("missing %q form field", uploadField), http.StatusBadRequest)
+				return
+			}
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				response.Err(w, r, "request body is too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			if err != nil {
+				response.Err(w, r, fmt.Sprintf("invalid request body: %s", err), http.StatusBadRequest)
+				return
+			}
+			if part.FormName() != uploadField {
+				part.Close()
+				continue
+			}
+
+			media, err := i.mediaServ.Upload(r.Context(), part)
+			part.Close()
+			if err != nil {
+				response.Error(w, r, err)
+				return
+			}
+
+			render.Status(r, http.StatusCreated)
+			render.JSON(w, r, &uploadResponse{
+				Media: *media,
+			})
+			return
+		}
+	}
+}
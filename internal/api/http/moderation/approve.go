@@ -0,0 +1,33 @@
+package moderation
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type approveResponse struct {
+	Status string `json:"status"`
+}
+
+func (i *Implementation) ApproveHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		entityType := chi.URLParam(r, "entityType")
+		id, err := strconv.Atoi(chi.URLParam(r, "entityId"))
+		if err != nil {
+			response.Err(w, r, "invalid entity id", http.StatusBadRequest)
+			return
+		}
+
+		if err = i.moderationServ.Approve(r.Context(), entityType, id); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &approveResponse{
+			Status: "ok",
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package moderation
+
+import (
+	"github.com/nogavadu/articles-service/internal/lib/api/openapi"
+	"net/http"
+)
+
+// Operations documents the moderation routes relative to their mount point.
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method:   http.MethodGet,
+			Path:     "/queue",
+			Summary:  "List crops, categories, articles and pests awaiting review",
+			Auth:     true,
+			Response: getQueueResponse{},
+			Errors:   []int{http.StatusForbidden},
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/{entityType}/{entityId}/approve",
+			Summary:  "Publish an item under review",
+			Auth:     true,
+			Response: approveResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusConflict},
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/{entityType}/{entityId}/reject",
+			Summary:  "Reject an item under review",
+			Auth:     true,
+			Request:  rejectRequest{},
+			Response: rejectResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusConflict},
+		},
+	}
+}
@@ -0,0 +1,26 @@
+package moderation
+
+import (
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+)
+
+type getQueueResponse struct {
+	Data []model.ModerationItem `json:"data"`
+}
+
+func (i *Implementation) GetQueueHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		items, err := i.moderationServ.GetQueue(r.Context())
+		if err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &getQueueResponse{
+			Data: items,
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package moderation
+
+import (
+	"encoding/json"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/request"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type rejectRequest struct {
+	model.ModerationRejectInput
+}
+
+type rejectResponse struct {
+	Status string `json:"status"`
+}
+
+func (i *Implementation) RejectHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		entityType := chi.URLParam(r, "entityType")
+		id, err := strconv.Atoi(chi.URLParam(r, "entityId"))
+		if err != nil {
+			response.Err(w, r, "invalid entity id", http.StatusBadRequest)
+			return
+		}
+
+		var reqData rejectRequest
+		if err = json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+			response.Err(w, r, "invalid request body format", http.StatusBadRequest)
+			return
+		}
+		if err = request.Validate(&reqData); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		if err = i.moderationServ.Reject(r.Context(), entityType, id, reqData.Reason); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &rejectResponse{
+			Status: "ok",
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package moderation
+
+import (
+	"github.com/nogavadu/articles-service/internal/service"
+)
+
+type Implementation struct {
+	moderationServ service.ModerationService
+}
+
+func New(moderationService service.ModerationService) *Implementation {
+	return &Implementation{
+		moderationServ: moderationService,
+	}
+}
@@ -0,0 +1,37 @@
+package pest
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type addArticleResponse struct {
+	Status string `json:"status"`
+}
+
+func (i *Implementation) AddArticleHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pestId, err := strconv.Atoi(chi.URLParam(r, "pestId"))
+		if err != nil {
+			response.Err(w, r, "invalid pest id", http.StatusBadRequest)
+			return
+		}
+		articleId, err := strconv.Atoi(chi.URLParam(r, "articleId"))
+		if err != nil {
+			response.Err(w, r, "invalid article id", http.StatusBadRequest)
+			return
+		}
+
+		if err = i.pestServ.AddArticle(r.Context(), pestId, articleId); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &addArticleResponse{
+			Status: "ok",
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package pest
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/request"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+)
+
+type createResponse struct {
+	Id int `json:"id"`
+}
+
+func (i *Implementation) CreateHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var reqData model.PestInfo
+		if err := json.NewDecoder(r.Body).Decode(&reqData); err != nil {
+			response.Err(w, r, fmt.Sprintf("invalid request body: %s", err), http.StatusBadRequest)
+			return
+		}
+		if err := request.Validate(&reqData); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		id, err := i.pestServ.Create(r.Context(), &reqData)
+		if err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.Status(r, http.StatusCreated)
+		render.JSON(w, r, &createResponse{
+			Id: id,
+		})
+	}
+}
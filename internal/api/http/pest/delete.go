@@ -0,0 +1,32 @@
+package pest
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type deleteResponse struct {
+	Status string `json:"status"`
+}
+
+func (i *Implementation) DeleteHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.Atoi(chi.URLParam(r, "pestId"))
+		if err != nil {
+			response.Err(w, r, "invalid pest id", http.StatusBadRequest)
+			return
+		}
+
+		if err = i.pestServ.Delete(r.Context(), id); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &deleteResponse{
+			Status: "ok",
+		})
+	}
+}
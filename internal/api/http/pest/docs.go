@@ -0,0 +1,90 @@
+package pest
+
+import (
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/openapi"
+	"net/http"
+)
+
+// Operations documents the pest routes relative to their mount point.
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method:  http.MethodGet,
+			Path:    "/",
+			Summary: "List pests and diseases",
+			Query: []openapi.Param{
+				{Name: "status", Description: "Only pests with this status, published by default"},
+				{Name: "crop_id", Type: "integer", Description: "Only pests affecting this crop"},
+			},
+			Response: getAllResponse{},
+			Errors:   []int{http.StatusBadRequest},
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/{pestId}",
+			Summary:  "Get a pest with the crops it affects and its treatment articles",
+			Response: getByIdResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/",
+			Summary:  "Create a pest",
+			Auth:     true,
+			Request:  model.PestInfo{},
+			Response: createResponse{},
+			Status:   http.StatusCreated,
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden, http.StatusConflict},
+		},
+		{
+			Method:   http.MethodPatch,
+			Path:     "/{pestId}",
+			Summary:  "Update a pest",
+			Auth:     true,
+			Request:  model.UpdatePestInput{},
+			Response: updateResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden, http.StatusConflict},
+		},
+		{
+			Method:   http.MethodDelete,
+			Path:     "/{pestId}",
+			Summary:  "Move a pest to the trash",
+			Auth:     true,
+			Response: deleteResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden},
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/{pestId}/crops/{cropId}",
+			Summary:  "Mark a crop as affected by a pest",
+			Auth:     true,
+			Response: addCropResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden, http.StatusConflict},
+		},
+		{
+			Method:   http.MethodDelete,
+			Path:     "/{pestId}/crops/{cropId}",
+			Summary:  "Unlink a crop from a pest",
+			Auth:     true,
+			Response: removeCropResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden},
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/{pestId}/articles/{articleId}",
+			Summary:  "Link a treatment article to a pest",
+			Auth:     true,
+			Response: addArticleResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden, http.StatusConflict},
+		},
+		{
+			Method:   http.MethodDelete,
+			Path:     "/{pestId}/articles/{articleId}",
+			Summary:  "Unlink a treatment article from a pest",
+			Auth:     true,
+			Response: removeArticleResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden},
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package pest
+
+import (
+	"errors"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type getAllResponse struct {
+	Data []model.Pest `json:"data"`
+}
+
+func (i *Implementation) GetAllHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params, err := pestGetAllParams(r)
+		if err != nil {
+			response.Err(w, r, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		pests, err := i.pestServ.GetAll(r.Context(), params)
+		if err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &getAllResponse{
+			Data: pests,
+		})
+	}
+}
+
+func pestGetAllParams(r *http.Request) (*model.PestGetAllParams, error) {
+	params := &model.PestGetAllParams{}
+
+	status := r.URL.Query().Get("status")
+	if status != "" {
+		params.Status = &status
+	}
+
+	cropIdStr := r.URL.Query().Get("crop_id")
+	if cropIdStr != "" {
+		id, err := strconv.Atoi(cropIdStr)
+		if err != nil {
+			return nil, errors.New("invalid crop_id query param")
+		}
+		params.CropId = &id
+	}
+
+	return params, nil
+}
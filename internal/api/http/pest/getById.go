@@ -0,0 +1,34 @@
+package pest
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type getByIdResponse struct {
+	model.Pest
+}
+
+func (i *Implementation) GetByIdHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pestId, err := strconv.Atoi(chi.URLParam(r, "pestId"))
+		if err != nil {
+			response.Err(w, r, "invalid pest id", http.StatusBadRequest)
+			return
+		}
+
+		pest, err := i.pestServ.GetById(r.Context(), pestId)
+		if err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &getByIdResponse{
+			Pest: *pest,
+		})
+	}
+}
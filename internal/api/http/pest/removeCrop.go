@@ -0,0 +1,37 @@
+package pest
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type removeCropResponse struct {
+	Status string `json:"status"`
+}
+
+func (i *Implementation) RemoveCropHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pestId, err := strconv.Atoi(chi.URLParam(r, "pestId"))
+		if err != nil {
+			response.Err(w, r, "invalid pest id", http.StatusBadRequest)
+			return
+		}
+		cropId, err := strconv.Atoi(chi.URLParam(r, "cropId"))
+		if err != nil {
+			response.Err(w, r, "invalid crop id", http.StatusBadRequest)
+			return
+		}
+
+		if err = i.pestServ.RemoveCrop(r.Context(), pestId, cropId); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &removeCropResponse{
+			Status: "ok",
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package pest
+
+import (
+	"github.com/nogavadu/articles-service/internal/service"
+)
+
+type Implementation struct {
+	pestServ service.PestService
+}
+
+func New(pestService service.PestService) *Implementation {
+	return &Implementation{
+		pestServ: pestService,
+	}
+}
@@ -0,0 +1,36 @@
+package trash
+
+import (
+	"github.com/nogavadu/articles-service/internal/lib/api/openapi"
+	"net/http"
+)
+
+// Operations documents the trash routes relative to their mount point.
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method:   http.MethodGet,
+			Path:     "/",
+			Summary:  "List trashed crops, categories, articles and pests",
+			Auth:     true,
+			Response: getAllResponse{},
+			Errors:   []int{http.StatusForbidden},
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/{entityType}/{entityId}/restore",
+			Summary:  "Restore a trashed item",
+			Auth:     true,
+			Response: restoreResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound},
+		},
+		{
+			Method:   http.MethodDelete,
+			Path:     "/{entityType}/{entityId}",
+			Summary:  "Permanently delete a trashed item",
+			Auth:     true,
+			Response: deleteResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound},
+		},
+	}
+}
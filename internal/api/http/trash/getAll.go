@@ -0,0 +1,26 @@
+package trash
+
+import (
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+)
+
+type getAllResponse struct {
+	Data []model.TrashItem `json:"data"`
+}
+
+func (i *Implementation) GetAllHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		items, err := i.trashServ.GetAll(r.Context())
+		if err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &getAllResponse{
+			Data: items,
+		})
+	}
+}
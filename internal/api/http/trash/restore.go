@@ -0,0 +1,33 @@
+package trash
+
+import (
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"strconv"
+)
+
+type restoreResponse struct {
+	Status string `json:"status"`
+}
+
+func (i *Implementation) RestoreHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		entityType := chi.URLParam(r, "entityType")
+		id, err := strconv.Atoi(chi.URLParam(r, "entityId"))
+		if err != nil {
+			response.Err(w, r, "invalid entity id", http.StatusBadRequest)
+			return
+		}
+
+		if err = i.trashServ.Restore(r.Context(), entityType, id); err != nil {
+			response.Error(w, r, err)
+			return
+		}
+
+		render.JSON(w, r, &restoreResponse{
+			Status: "ok",
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package trash
+
+import (
+	"github.com/nogavadu/articles-service/internal/service"
+)
+
+type Implementation struct {
+	trashServ service.TrashService
+}
+
+func New(trashService service.TrashService) *Implementation {
+	return &Implementation{
+		trashServ: trashService,
+	}
+}
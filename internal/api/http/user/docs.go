@@ -0,0 +1,28 @@
+package user
+
+import (
+	"github.com/nogavadu/articles-service/internal/lib/api/openapi"
+	"net/http"
+)
+
+// Operations documents the user routes relative to their mount point.
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method:   http.MethodGet,
+			Path:     "/{userId}",
+			Summary:  "Get a user",
+			Response: getByIdResponse{},
+			Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+		},
+		{
+			Method:   http.MethodPatch,
+			Path:     "/{userId}",
+			Summary:  "Update a user",
+			Auth:     true,
+			Request:  updateRequest{},
+			Response: updateResponse{},
+			Errors:   []int{http.StatusBadRequest},
+		},
+	}
+}
@@ -28,7 +28,8 @@ func (i *Implementation) GetByIdHandler() http.HandlerFunc {
 
 		user, err := i.userServ.GetById(r.Context(), userId)
 		if err != nil {
-			response.Err(w, r, err.Error(), http.StatusNotFound)
+			response.Error(w, r, err)
+			return
 		}
 
 		render.JSON(w, r, &getByIdResponse{
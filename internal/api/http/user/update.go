@@ -49,7 +49,7 @@ func (i *Implementation) UpdateHandler() http.HandlerFunc {
 		}
 
 		if err = i.userServ.Update(r.Context(), userId, &reqBody.UserUpdateInput); err != nil {
-			response.Err(w, r, err.Error(), http.StatusInternalServerError)
+			response.Error(w, r, err)
 			return
 		}
 
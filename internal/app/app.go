@@ -3,17 +3,38 @@ package app
 import (
 	"context"
 	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
-	"github.com/nogavadu/articles-service/internal/middlewares"
+	"github.com/nogavadu/articles-service/internal/api/http/article"
+	"github.com/nogavadu/articles-service/internal/api/http/audit"
+	"github.com/nogavadu/articles-service/internal/api/http/auth"
+	"github.com/nogavadu/articles-service/internal/api/http/calendar"
+	"github.com/nogavadu/articles-service/internal/api/http/category"
+	"github.com/nogavadu/articles-service/internal/api/http/companion"
+	"github.com/nogavadu/articles-service/internal/api/http/crop"
+	"github.com/nogavadu/articles-service/internal/api/http/media"
+	"github.com/nogavadu/articles-service/internal/api/http/moderation"
+	"github.com/nogavadu/articles-service/internal/api/http/pest"
+	"github.com/nogavadu/articles-service/internal/api/http/trash"
+	"github.com/nogavadu/articles-service/internal/api/http/user"
+	"github.com/nogavadu/articles-service/internal/lib/api/openapi"
+	desc "github.com/nogavadu/articles-service/pkg/articles_v1"
 	"github.com/nogavadu/platform_common/pkg/closer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/reflection"
 	"log/slog"
+	"net"
 	"net/http"
 	"strconv"
+	"sync"
+	"time"
 )
 
 type App struct {
 	serviceProvider *serviceProvider
 	httpServer      *chi.Mux
+	grpcServer      *grpc.Server
 }
 
 func New(ctx context.Context) (*App, error) {
@@ -32,13 +53,38 @@ func (a *App) Run() error {
 		closer.Wait()
 	}()
 
-	return a.runHttpServer()
+	ctx, cancel := context.WithCancel(context.Background())
+	closer.Add(func() error {
+		cancel()
+		return nil
+	})
+
+	go a.runTrashPurger(ctx)
+	go a.renderPendingArticles(ctx)
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		_ = a.runGRPCServer()
+	}()
+
+	go func() {
+		defer wg.Done()
+		_ = a.runHttpServer()
+	}()
+
+	wg.Wait()
+
+	return nil
 }
 
 func (a *App) initDeps(ctx context.Context) error {
 	inits := []func(context.Context) error{
 		a.initServiceProvider,
 		a.initHttpServer,
+		a.initGRPCServer,
 	}
 
 	for _, f := range inits {
@@ -63,7 +109,7 @@ func (a *App) initAuthAPI(r chi.Router) {
 	r.Post("/login", authApi.LoginHandler())
 
 	r.Group(func(r chi.Router) {
-		r.Use(middlewares.AuthMiddleware)
+		r.Use(a.serviceProvider.AuthMiddleware())
 
 		r.Get("/refreshToken", authApi.GetRefreshTokenHandler())
 	})
@@ -76,7 +122,7 @@ func (a *App) initUserAPI(r chi.Router) {
 		r.Get("/{userId}", userApi.GetByIdHandler())
 
 		r.Group(func(r chi.Router) {
-			r.Use(middlewares.AuthMiddleware)
+			r.Use(a.serviceProvider.AuthMiddleware())
 
 			r.Patch("/{userId}", userApi.UpdateHandler())
 		})
@@ -85,20 +131,35 @@ func (a *App) initUserAPI(r chi.Router) {
 
 func (a *App) initCropAPI(ctx context.Context, r chi.Router) {
 	cropApi := a.serviceProvider.CropImpl(ctx)
+	calendarApi := a.serviceProvider.CalendarImpl(ctx)
+	companionApi := a.serviceProvider.CompanionImpl(ctx)
+
+	r.Get("/calendar", calendarApi.GetByZoneHandler())
 
 	r.Route("/crops", func(r chi.Router) {
 		r.Get("/", cropApi.GetAllHandler())
 		r.Get("/{cropId}", cropApi.GetByIdHandler())
+		r.Get("/{cropId}/calendar", calendarApi.GetAllHandler())
+		r.Get("/{cropId}/companions", companionApi.GetAllHandler())
+		r.Post("/check-bed", companionApi.CheckBedHandler())
 
 		r.Group(func(r chi.Router) {
-			r.Use(middlewares.AuthMiddleware)
+			r.Use(a.serviceProvider.AuthMiddleware())
 
 			r.Post("/", cropApi.CreateHandler())
 			r.Patch("/{cropId}", cropApi.UpdateHandler())
+			r.Put("/{cropId}/parent", cropApi.SetParentHandler())
 			r.Delete("/{cropId}", cropApi.DeleteHandler())
 
 			r.Post("/{cropId}/{categoryId}", cropApi.AddRelationHandler())
 			r.Delete("/{cropId}/{categoryId}", cropApi.RemoveRelationHandler())
+
+			r.Post("/{cropId}/calendar", calendarApi.CreateHandler())
+			r.Patch("/{cropId}/calendar/{entryId}", calendarApi.UpdateHandler())
+			r.Delete("/{cropId}/calendar/{entryId}", calendarApi.DeleteHandler())
+
+			r.Put("/{cropId}/companions/{companionId}", companionApi.SetHandler())
+			r.Delete("/{cropId}/companions/{companionId}", companionApi.DeleteHandler())
 		})
 	})
 }
@@ -111,10 +172,11 @@ func (a *App) initCategoryAPI(ctx context.Context, r chi.Router) {
 		r.Get("/{categoryId}", categoryApi.GetByIdHandler())
 
 		r.Group(func(r chi.Router) {
-			r.Use(middlewares.AuthMiddleware)
+			r.Use(a.serviceProvider.AuthMiddleware())
 
 			r.Post("/", categoryApi.CreateHandler())
 			r.Patch("/{categoryId}", categoryApi.UpdateHandler())
+			r.Post("/{categoryId}/move", categoryApi.MoveHandler())
 			r.Delete("/{categoryId}", categoryApi.DeleteHandler())
 		})
 	})
@@ -126,20 +188,121 @@ func (a *App) initArticleAPI(ctx context.Context, r chi.Router) {
 	r.Route("/articles", func(r chi.Router) {
 		r.Get("/", articleApi.GetAllHandler())
 		r.Get("/{articleId}", articleApi.GetByIDHandler())
+		r.Get("/{articleId}/revisions", articleApi.GetRevisionsHandler())
+		r.Get("/{articleId}/revisions/diff", articleApi.DiffRevisionsHandler())
+		r.Get("/{articleId}/revisions/{revisionId}", articleApi.GetRevisionHandler())
+		r.Get("/{articleId}/relations", articleApi.GetRelationsHandler())
 
 		r.Group(func(r chi.Router) {
-			r.Use(middlewares.AuthMiddleware)
+			r.Use(a.serviceProvider.AuthMiddleware())
 
 			r.Post("/", articleApi.CreateHandler())
 			r.Patch("/{articleId}", articleApi.UpdateHandler())
 			r.Delete("/{articleId}", articleApi.DeleteHandler())
+
+			r.Post("/{articleId}/revisions/{revisionId}/restore", articleApi.RestoreRevisionHandler())
+
+			r.Post("/{articleId}/relations", articleApi.AddRelationHandler())
+			r.Delete("/{articleId}/relations/{cropId}/{categoryId}", articleApi.RemoveRelationHandler())
+
+			r.Post("/{articleId}/images", articleApi.AddImageHandler())
+			r.Put("/{articleId}/images/order", articleApi.ReorderImagesHandler())
+			r.Patch("/{articleId}/images/{imageId}", articleApi.UpdateImageHandler())
+			r.Delete("/{articleId}/images/{imageId}", articleApi.RemoveImageHandler())
+			r.Get("/relations/inconsistent", articleApi.GetInconsistentRelationsHandler())
+		})
+	})
+}
+
+func (a *App) initPestAPI(ctx context.Context, r chi.Router) {
+	pestApi := a.serviceProvider.PestImpl(ctx)
+
+	r.Route("/pests", func(r chi.Router) {
+		r.Get("/", pestApi.GetAllHandler())
+		r.Get("/{pestId}", pestApi.GetByIdHandler())
+
+		r.Group(func(r chi.Router) {
+			r.Use(a.serviceProvider.AuthMiddleware())
+
+			r.Post("/", pestApi.CreateHandler())
+			r.Patch("/{pestId}", pestApi.UpdateHandler())
+			r.Delete("/{pestId}", pestApi.DeleteHandler())
+
+			r.Post("/{pestId}/crops/{cropId}", pestApi.AddCropHandler())
+			r.Delete("/{pestId}/crops/{cropId}", pestApi.RemoveCropHandler())
+			r.Post("/{pestId}/articles/{articleId}", pestApi.AddArticleHandler())
+			r.Delete("/{pestId}/articles/{articleId}", pestApi.RemoveArticleHandler())
+		})
+	})
+}
+
+func (a *App) initMediaAPI(ctx context.Context, r chi.Router) {
+	mediaApi := a.serviceProvider.MediaImpl(ctx)
+
+	r.Route("/media", func(r chi.Router) {
+		r.Get("/{mediaId}", mediaApi.GetByIdHandler())
+		r.Get("/files/{key}", mediaApi.ServeHandler())
+
+		r.Group(func(r chi.Router) {
+			r.Use(a.serviceProvider.AuthMiddleware())
+
+			r.Post("/", mediaApi.UploadHandler())
 		})
 	})
 }
 
+func (a *App) initModerationAPI(ctx context.Context, r chi.Router) {
+	moderationApi := a.serviceProvider.ModerationImpl(ctx)
+
+	r.Route("/moderation", func(r chi.Router) {
+		r.Use(a.serviceProvider.AuthMiddleware())
+
+		r.Get("/queue", moderationApi.GetQueueHandler())
+		r.Post("/{entityType}/{entityId}/approve", moderationApi.ApproveHandler())
+		r.Post("/{entityType}/{entityId}/reject", moderationApi.RejectHandler())
+	})
+}
+
+func (a *App) initAuditAPI(ctx context.Context, r chi.Router) {
+	auditApi := a.serviceProvider.AuditImpl(ctx)
+
+	r.Route("/audit", func(r chi.Router) {
+		r.Use(a.serviceProvider.AuthMiddleware())
+
+		r.Get("/", auditApi.GetAllHandler())
+	})
+}
+
+func (a *App) initTrashAPI(ctx context.Context, r chi.Router) {
+	trashApi := a.serviceProvider.TrashImpl(ctx)
+
+	r.Route("/trash", func(r chi.Router) {
+		r.Use(a.serviceProvider.AuthMiddleware())
+
+		r.Get("/", trashApi.GetAllHandler())
+		r.Post("/{entityType}/{entityId}/restore", trashApi.RestoreHandler())
+		r.Delete("/{entityType}/{entityId}", trashApi.DeleteHandler())
+	})
+}
+
 func (a *App) initHttpServer(ctx context.Context) error {
+	router, doc := a.httpRouter(ctx)
+
+	// A stale document only misleads clients, it is no reason to refuse serving.
+	if err := openapi.Verify(router, doc); err != nil {
+		a.serviceProvider.Logger().Warn("openapi document is out of sync with the routes", slog.String("error", err.Error()))
+	}
+
+	a.httpServer = router
+
+	return nil
+}
+
+// httpRouter registers every http route and returns the router with its openapi document.
+func (a *App) httpRouter(ctx context.Context) (*chi.Mux, *openapi.Document) {
 	router := chi.NewRouter()
 
+	router.Use(middleware.RequestID)
 	router.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   []string{"https://*", "http://*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
@@ -148,15 +311,86 @@ func (a *App) initHttpServer(ctx context.Context) error {
 		MaxAge:           300, // 5 минут
 	}))
 
+	doc := openapi.New(openapi.Info{Title: "articles-service", Version: "1.0.0"}, a.httpOperations())
+
 	router.Route("/api", func(r chi.Router) {
+		r.Get("/openapi.json", openapi.Handler(doc))
+
 		a.initAuthAPI(r)
 		a.initUserAPI(r)
 		a.initCropAPI(ctx, r)
 		a.initCategoryAPI(ctx, r)
 		a.initArticleAPI(ctx, r)
+		a.initPestAPI(ctx, r)
+		a.initMediaAPI(ctx, r)
+		a.initModerationAPI(ctx, r)
+		a.initAuditAPI(ctx, r)
+		a.initTrashAPI(ctx, r)
 	})
 
-	a.httpServer = router
+	return router, doc
+}
+
+// httpOperations documents every route registered in httpRouter.
+// Keep it in sync with the routes, TestHttpRoutesDocumented fails otherwise.
+func (a *App) httpOperations() []openapi.Operation {
+	ops := []openapi.Operation{
+		{
+			Method:   http.MethodGet,
+			Path:     "/api/openapi.json",
+			Tag:      "docs",
+			Summary:  "This document",
+			Response: map[string]any{},
+		},
+	}
+	ops = append(ops, openapi.Mount("/api", "auth", auth.Operations())...)
+	ops = append(ops, openapi.Mount("/api/users", "users", user.Operations())...)
+	ops = append(ops, openapi.Mount("/api/crops", "crops", crop.Operations())...)
+	ops = append(ops, openapi.Mount("/api", "calendar", calendar.Operations())...)
+	ops = append(ops, openapi.Mount("/api/crops", "companions", companion.Operations())...)
+	ops = append(ops, openapi.Mount("/api/categories", "categories", category.Operations())...)
+	ops = append(ops, openapi.Mount("/api/articles", "articles", article.Operations())...)
+	ops = append(ops, openapi.Mount("/api/pests", "pests", pest.Operations())...)
+	ops = append(ops, openapi.Mount("/api/media", "media", media.Operations())...)
+	ops = append(ops, openapi.Mount("/api/moderation", "moderation", moderation.Operations())...)
+	ops = append(ops, openapi.Mount("/api/audit", "audit", audit.Operations())...)
+	ops = append(ops, openapi.Mount("/api/trash", "trash", trash.Operations())...)
+
+	return ops
+}
+
+func (a *App) initGRPCServer(ctx context.Context) error {
+	a.grpcServer = grpc.NewServer(
+		grpc.Creds(insecure.NewCredentials()),
+		grpc.UnaryInterceptor(a.serviceProvider.AuthInterceptor()),
+	)
+	closer.Add(func() error {
+		a.grpcServer.GracefulStop()
+		return nil
+	})
+
+	reflection.Register(a.grpcServer)
+
+	desc.RegisterArticlesV1Server(a.grpcServer, a.serviceProvider.ArticlesGRPCImpl(ctx))
+
+	return nil
+}
+
+func (a *App) runGRPCServer() error {
+	a.serviceProvider.Logger().Info(
+		"starting grpc server", slog.String("port", strconv.Itoa(a.serviceProvider.GRPCServerConfig().Port())),
+	)
+
+	lis, err := net.Listen("tcp", a.serviceProvider.GRPCServerConfig().Address())
+	if err != nil {
+		a.serviceProvider.Logger().Error("failed to start grpc server", slog.String("error", err.Error()))
+		return err
+	}
+
+	if err = a.grpcServer.Serve(lis); err != nil {
+		a.serviceProvider.Logger().Error("failed to start grpc server", slog.String("error", err.Error()))
+		return err
+	}
 
 	return nil
 }
@@ -174,3 +408,40 @@ func (a *App) runHttpServer() error {
 
 	return nil
 }
+
+// runTrashPurger periodically deletes trashed items older than the configured retention.
+func (a *App) runTrashPurger(ctx context.Context) {
+	log := a.serviceProvider.Logger().With(slog.String("op", "app.runTrashPurger"))
+	trashService := a.serviceProvider.TrashService(ctx)
+
+	ticker := time.NewTicker(a.serviceProvider.TrashConfig().PurgeInterval())
+	defer ticker.Stop()
+
+	for {
+		purged, err := trashService.Purge(ctx)
+		if err != nil {
+			log.Error("failed to purge trash", slog.String("error", err.Error()))
+		} else if purged > 0 {
+			log.Info("purged trash", slog.Int("count", purged))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// renderPendingArticles renders the content of the articles written before html was rendered on write.
+func (a *App) renderPendingArticles(ctx context.Context) {
+	log := a.serviceProvider.Logger().With(slog.String("op", "app.renderPendingArticles"))
+
+	rendered, err := a.serviceProvider.ArticleService(ctx).RenderPending(ctx)
+	if err != nil {
+		log.Error("failed to render articles", slog.String("error", err.Error()))
+	}
+	if rendered > 0 {
+		log.Info("rendered articles", slog.Int("count", rendered))
+	}
+}
@@ -0,0 +1,81 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"github.com/nogavadu/articles-service/internal/lib/api/openapi"
+	"github.com/nogavadu/articles-service/internal/service"
+	"io"
+	"log/slog"
+	"net/http"
+	"testing"
+)
+
+// The stubs only satisfy the interfaces, registering routes never calls the services.
+type (
+	authServiceStub       struct{ service.AuthService }
+	userServiceStub       struct{ service.UserService }
+	cropServiceStub       struct{ service.CropService }
+	calendarServiceStub   struct{ service.CalendarService }
+	companionServiceStub  struct{ service.CompanionService }
+	pestServiceStub       struct{ service.PestService }
+	mediaServiceStub      struct{ service.MediaService }
+	categoryServiceStub   struct{ service.CategoryService }
+	articleServiceStub    struct{ service.ArticleService }
+	moderationServiceStub struct{ service.ModerationService }
+	auditServiceStub      struct{ service.AuditService }
+	trashServiceStub      struct{ service.TrashService }
+)
+
+func newTestApp() *App {
+	return &App{
+		serviceProvider: &serviceProvider{
+			logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+			authMiddleware: func(next http.Handler) http.Handler {
+				return next
+			},
+			authService:       authServiceStub{},
+			userService:       userServiceStub{},
+			cropService:       cropServiceStub{},
+			calendarService:   calendarServiceStub{},
+			companionService:  companionServiceStub{},
+			pestService:       pestServiceStub{},
+			mediaService:      mediaServiceStub{},
+			categoryService:   categoryServiceStub{},
+			articleService:    articleServiceStub{},
+			moderationService: moderationServiceStub{},
+			auditService:      auditServiceStub{},
+			trashService:      trashServiceStub{},
+		},
+	}
+}
+
+func TestHttpRoutesDocumented(t *testing.T) {
+	router, doc := newTestApp().httpRouter(context.Background())
+
+	if err := openapi.Verify(router, doc); err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+}
+
+func TestVerifyUndocumentedRoute(t *testing.T) {
+	router, doc := newTestApp().httpRouter(context.Background())
+	router.Get("/api/undocumented", func(w http.ResponseWriter, r *http.Request) {})
+
+	if err := openapi.Verify(router, doc); !errors.Is(err, openapi.ErrUndocumentedRoutes) {
+		t.Fatalf("Verify() error = %v, want %v", err, openapi.ErrUndocumentedRoutes)
+	}
+}
+
+func TestVerifyUnroutedOperation(t *testing.T) {
+	a := newTestApp()
+	router, _ := a.httpRouter(context.Background())
+	doc := openapi.New(openapi.Info{Title: "articles-service", Version: "1.0.0"}, append(a.httpOperations(), openapi.Operation{
+		Method: http.MethodGet,
+		Path:   "/api/unrouted",
+	}))
+
+	if err := openapi.Verify(router, doc); !errors.Is(err, openapi.ErrUndocumentedRoutes) {
+		t.Fatalf("Verify() error = %v, want %v", err, openapi.ErrUndocumentedRoutes)
+	}
+}
@@ -2,65 +2,132 @@ package app
 
 import (
 	"context"
+	articlesGRPC "github.com/nogavadu/articles-service/internal/api/grpc/articles"
 	"github.com/nogavadu/articles-service/internal/api/http/article"
+	"github.com/nogavadu/articles-service/internal/api/http/audit"
 	"github.com/nogavadu/articles-service/internal/api/http/auth"
+	"github.com/nogavadu/articles-service/internal/api/http/calendar"
 	"github.com/nogavadu/articles-service/internal/api/http/category"
+	"github.com/nogavadu/articles-service/internal/api/http/companion"
 	"github.com/nogavadu/articles-service/internal/api/http/crop"
+	"github.com/nogavadu/articles-service/internal/api/http/media"
+	"github.com/nogavadu/articles-service/internal/api/http/moderation"
+	"github.com/nogavadu/articles-service/internal/api/http/pest"
+	"github.com/nogavadu/articles-service/internal/api/http/trash"
 	"github.com/nogavadu/articles-service/internal/api/http/user"
 	"github.com/nogavadu/articles-service/internal/clients/auth-service/grpc"
 	"github.com/nogavadu/articles-service/internal/config"
 	"github.com/nogavadu/articles-service/internal/config/env"
+	"github.com/nogavadu/articles-service/internal/middlewares"
 	"github.com/nogavadu/articles-service/internal/repository"
 	articleRepo "github.com/nogavadu/articles-service/internal/repository/article"
 	articleImagesRepo "github.com/nogavadu/articles-service/internal/repository/article_images"
 	articleRelationsRepo "github.com/nogavadu/articles-service/internal/repository/article_relations"
+	articleRevisionsRepo "github.com/nogavadu/articles-service/internal/repository/article_revisions"
+	auditLogRepo "github.com/nogavadu/articles-service/internal/repository/audit_log"
 	categoryRepo "github.com/nogavadu/articles-service/internal/repository/category"
 	cropRepo "github.com/nogavadu/articles-service/internal/repository/crop"
+	cropCalendarRepo "github.com/nogavadu/articles-service/internal/repository/crop_calendar"
 	cropCategoriesRepo "github.com/nogavadu/articles-service/internal/repository/crop_categories"
+	cropCompanionsRepo "github.com/nogavadu/articles-service/internal/repository/crop_companions"
+	mediaRepo "github.com/nogavadu/articles-service/internal/repository/media"
+	mediaVariantsRepo "github.com/nogavadu/articles-service/internal/repository/media_variants"
+	pestRepo "github.com/nogavadu/articles-service/internal/repository/pest"
+	pestImagesRepo "github.com/nogavadu/articles-service/internal/repository/pest_images"
+	pestRelationsRepo "github.com/nogavadu/articles-service/internal/repository/pest_relations"
 	statusRepo "github.com/nogavadu/articles-service/internal/repository/status"
 	"github.com/nogavadu/articles-service/internal/service"
 	articleServ "github.com/nogavadu/articles-service/internal/service/article"
+	auditServ "github.com/nogavadu/articles-service/internal/service/audit"
 	authServ "github.com/nogavadu/articles-service/internal/service/auth"
+	calendarServ "github.com/nogavadu/articles-service/internal/service/calendar"
 	categoryServ "github.com/nogavadu/articles-service/internal/service/category"
+	companionServ "github.com/nogavadu/articles-service/internal/service/companion"
 	cropServ "github.com/nogavadu/articles-service/internal/service/crop"
+	mediaServ "github.com/nogavadu/articles-service/internal/service/media"
+	moderationServ "github.com/nogavadu/articles-service/internal/service/moderation"
+	pestServ "github.com/nogavadu/articles-service/internal/service/pest"
+	"github.com/nogavadu/articles-service/internal/service/policy"
+	trashServ "github.com/nogavadu/articles-service/internal/service/trash"
 	userServ "github.com/nogavadu/articles-service/internal/service/user"
+	"github.com/nogavadu/articles-service/internal/service/workflow"
+	"github.com/nogavadu/articles-service/internal/storage"
+	"github.com/nogavadu/articles-service/internal/storage/local"
 	"github.com/nogavadu/platform_common/pkg/db"
 	"github.com/nogavadu/platform_common/pkg/db/pg"
 	"github.com/nogavadu/platform_common/pkg/db/transaction"
+	grpcLib "google.golang.org/grpc"
 	"log/slog"
+	"net/http"
 	"os"
 )
 
 type serviceProvider struct {
 	httpServerConfig  config.HTTPServerConfig
+	grpcServerConfig  config.GRPCServerConfig
 	pgConfig          config.PGConfig
 	authServiceConfig config.AuthServiceConfig
+	trashConfig       config.TrashConfig
+	mediaConfig       config.MediaConfig
 
 	logger *slog.Logger
 
-	authImpl     *auth.Implementation
-	cropImpl     *crop.Implementation
-	categoryImpl *category.Implementation
-	articlesImpl *article.Implementation
-	userImpl     *user.Implementation
-
-	authService     service.AuthService
-	cropService     service.CropService
-	categoryService service.CategoryService
-	articleService  service.ArticleService
-	userService     service.UserService
+	authMiddleware  func(http.Handler) http.Handler
+	authInterceptor grpcLib.UnaryServerInterceptor
+
+	articlesGRPCImpl *articlesGRPC.Implementation
+
+	authImpl       *auth.Implementation
+	cropImpl       *crop.Implementation
+	calendarImpl   *calendar.Implementation
+	companionImpl  *companion.Implementation
+	pestImpl       *pest.Implementation
+	mediaImpl      *media.Implementation
+	categoryImpl   *category.Implementation
+	articlesImpl   *article.Implementation
+	userImpl       *user.Implementation
+	moderationImpl *moderation.Implementation
+	auditImpl      *audit.Implementation
+	trashImpl      *trash.Implementation
+
+	authService       service.AuthService
+	cropService       service.CropService
+	calendarService   service.CalendarService
+	companionService  service.CompanionService
+	pestService       service.PestService
+	mediaService      service.MediaService
+	categoryService   service.CategoryService
+	articleService    service.ArticleService
+	userService       service.UserService
+	statusWorkflow    service.StatusWorkflow
+	accessPolicy      service.AccessPolicy
+	moderationService service.ModerationService
+	auditService      service.AuditService
+	snapshotLoader    service.SnapshotLoader
+	trashService      service.TrashService
 
 	cropRepository             repository.CropRepository
 	categoryRepository         repository.CategoryRepository
 	cropsCategoriesRepository  repository.CropCategoriesRepository
+	cropCalendarRepository     repository.CropCalendarRepository
+	cropCompanionsRepository   repository.CropCompanionsRepository
+	pestRepository             repository.PestRepository
+	pestImagesRepository       repository.PestImagesRepository
+	pestRelationsRepository    repository.PestRelationsRepository
+	mediaRepository            repository.MediaRepository
+	mediaVariantsRepository    repository.MediaVariantsRepository
 	articleRepository          repository.ArticleRepository
 	articleImagesRepository    repository.ArticleImagesRepository
 	articleRelationsRepository repository.ArticleRelationsRepository
+	articleRevisionsRepository repository.ArticleRevisionsRepository
 	statusRepository           repository.StatusRepository
+	auditLogRepository         repository.AuditLogRepository
 
 	dbClient  db.Client
 	txManager db.TxManager
 
+	mediaStorage storage.Storage
+
 	authClient   *grpc.AuthServiceClient
 	accessClient *grpc.AccessServiceClient
 	userClient   *grpc.UserServiceClient
@@ -82,6 +149,18 @@ func (p *serviceProvider) HTTPServerConfig() config.HTTPServerConfig {
 	return p.httpServerConfig
 }
 
+func (p *serviceProvider) GRPCServerConfig() config.GRPCServerConfig {
+	if p.grpcServerConfig == nil {
+		grpcServerConfig, err := env.NewGRPCServerConfig()
+		if err != nil {
+			p.Logger().Error("failed to get grpcServerConfig", slog.String("err", err.Error()))
+			panic(err)
+		}
+		p.grpcServerConfig = grpcServerConfig
+	}
+	return p.grpcServerConfig
+}
+
 func (p *serviceProvider) PGConfig() config.PGConfig {
 	if p.pgConfig == nil {
 		pgConfig, err := env.NewPGConfig()
@@ -201,6 +280,55 @@ func (p *serviceProvider) UserClient() *grpc.UserServiceClient {
 	return p.userClient
 }
 
+func (p *serviceProvider) TrashConfig() config.TrashConfig {
+	if p.trashConfig == nil {
+		trashConfig, err := env.NewTrashConfig()
+		if err != nil {
+			p.Logger().Error("failed to get trashConfig", slog.String("err", err.Error()))
+			panic(err)
+		}
+		p.trashConfig = trashConfig
+	}
+	return p.trashConfig
+}
+
+func (p *serviceProvider) MediaConfig() config.MediaConfig {
+	if p.mediaConfig == nil {
+		mediaConfig, err := env.NewMediaConfig()
+		if err != nil {
+			p.Logger().Error("failed to get mediaConfig", slog.String("err", err.Error()))
+			panic(err)
+		}
+		p.mediaConfig = mediaConfig
+	}
+	return p.mediaConfig
+}
+
+func (p *serviceProvider) AuthMiddleware() func(http.Handler) http.Handler {
+	if p.authMiddleware == nil {
+		p.authMiddleware = middlewares.AuthMiddleware(p.AuthClient())
+	}
+	return p.authMiddleware
+}
+
+func (p *serviceProvider) AuthInterceptor() grpcLib.UnaryServerInterceptor {
+	if p.authInterceptor == nil {
+		p.authInterceptor = middlewares.AuthInterceptor(p.AuthClient(), articlesGRPC.PublicMethods...)
+	}
+	return p.authInterceptor
+}
+
+func (p *serviceProvider) ArticlesGRPCImpl(ctx context.Context) *articlesGRPC.Implementation {
+	if p.articlesGRPCImpl == nil {
+		p.articlesGRPCImpl = articlesGRPC.New(
+			p.CropService(ctx),
+			p.CategoryService(ctx),
+			p.ArticleService(ctx),
+		)
+	}
+	return p.articlesGRPCImpl
+}
+
 func (p *serviceProvider) CropImpl(ctx context.Context) *crop.Implementation {
 	if p.cropImpl == nil {
 		p.cropImpl = crop.New(p.CropService(ctx))
@@ -214,7 +342,12 @@ func (p *serviceProvider) CropService(ctx context.Context) service.CropService {
 			p.Logger(),
 			p.CropRepository(ctx),
 			p.CropCategoriesRepository(ctx),
+			p.MediaVariantsRepository(ctx),
 			p.StatusRepository(ctx),
+			p.StatusWorkflow(ctx),
+			p.AccessPolicy(),
+			p.AuditService(ctx),
+			p.SnapshotLoader(ctx),
 			p.TxManger(ctx),
 			p.AccessClient(),
 			p.AuthClient(),
@@ -224,6 +357,29 @@ func (p *serviceProvider) CropService(ctx context.Context) service.CropService {
 	return p.cropService
 }
 
+func (p *serviceProvider) StatusWorkflow(ctx context.Context) service.StatusWorkflow {
+	if p.statusWorkflow == nil {
+		p.statusWorkflow = workflow.New(
+			p.Logger(),
+			p.StatusRepository(ctx),
+			p.AccessClient(),
+			p.AuthClient(),
+		)
+	}
+	return p.statusWorkflow
+}
+
+func (p *serviceProvider) AccessPolicy() service.AccessPolicy {
+	if p.accessPolicy == nil {
+		p.accessPolicy = policy.New(
+			p.Logger(),
+			p.AccessClient(),
+			p.AuthClient(),
+		)
+	}
+	return p.accessPolicy
+}
+
 func (p *serviceProvider) CropRepository(ctx context.Context) repository.CropRepository {
 	if p.cropRepository == nil {
 		p.cropRepository = cropRepo.New(p.DBClient(ctx))
@@ -244,7 +400,12 @@ func (p *serviceProvider) CategoryService(ctx context.Context) service.CategoryS
 			p.Logger(),
 			p.CategoryRepository(ctx),
 			p.CropCategoriesRepository(ctx),
+			p.MediaVariantsRepository(ctx),
 			p.StatusRepository(ctx),
+			p.StatusWorkflow(ctx),
+			p.AccessPolicy(),
+			p.AuditService(ctx),
+			p.SnapshotLoader(ctx),
 			p.TxManger(ctx),
 			p.AccessClient(),
 			p.AuthClient(),
@@ -268,6 +429,161 @@ func (p *serviceProvider) CropCategoriesRepository(ctx context.Context) reposito
 	return p.cropsCategoriesRepository
 }
 
+func (p *serviceProvider) CalendarImpl(ctx context.Context) *calendar.Implementation {
+	if p.calendarImpl == nil {
+		p.calendarImpl = calendar.New(p.CalendarService(ctx))
+	}
+	return p.calendarImpl
+}
+
+func (p *serviceProvider) CalendarService(ctx context.Context) service.CalendarService {
+	if p.calendarService == nil {
+		p.calendarService = calendarServ.New(
+			p.Logger(),
+			p.CropCalendarRepository(ctx),
+			p.CropRepository(ctx),
+			p.StatusRepository(ctx),
+			p.StatusWorkflow(ctx),
+			p.AccessPolicy(),
+			p.AuditService(ctx),
+			p.TxManger(ctx),
+		)
+	}
+	return p.calendarService
+}
+
+func (p *serviceProvider) CropCalendarRepository(ctx context.Context) repository.CropCalendarRepository {
+	if p.cropCalendarRepository == nil {
+		p.cropCalendarRepository = cropCalendarRepo.New(p.DBClient(ctx))
+	}
+	return p.cropCalendarRepository
+}
+
+func (p *serviceProvider) CompanionImpl(ctx context.Context) *companion.Implementation {
+	if p.companionImpl == nil {
+		p.companionImpl = companion.New(p.CompanionService(ctx))
+	}
+	return p.companionImpl
+}
+
+func (p *serviceProvider) CompanionService(ctx context.Context) service.CompanionService {
+	if p.companionService == nil {
+		p.companionService = companionServ.New(
+			p.Logger(),
+			p.CropCompanionsRepository(ctx),
+			p.CropRepository(ctx),
+			p.StatusRepository(ctx),
+			p.AccessPolicy(),
+			p.AuditService(ctx),
+			p.TxManger(ctx),
+		)
+	}
+	return p.companionService
+}
+
+func (p *serviceProvider) CropCompanionsRepository(ctx context.Context) repository.CropCompanionsRepository {
+	if p.cropCompanionsRepository == nil {
+		p.cropCompanionsRepository = cropCompanionsRepo.New(p.DBClient(ctx))
+	}
+	return p.cropCompanionsRepository
+}
+
+func (p *serviceProvider) PestImpl(ctx context.Context) *pest.Implementation {
+	if p.pestImpl == nil {
+		p.pestImpl = pest.New(p.PestService(ctx))
+	}
+	return p.pestImpl
+}
+
+func (p *serviceProvider) PestService(ctx context.Context) service.PestService {
+	if p.pestService == nil {
+		p.pestService = pestServ.New(
+			p.Logger(),
+			p.PestRepository(ctx),
+			p.PestImagesRepository(ctx),
+			p.PestRelationsRepository(ctx),
+			p.CropRepository(ctx),
+			p.ArticleRepository(ctx),
+			p.StatusRepository(ctx),
+			p.StatusWorkflow(ctx),
+			p.AccessPolicy(),
+			p.AuditService(ctx),
+			p.SnapshotLoader(ctx),
+			p.TxManger(ctx),
+			p.UserClient(),
+		)
+	}
+	return p.pestService
+}
+
+func (p *serviceProvider) PestRepository(ctx context.Context) repository.PestRepository {
+	if p.pestRepository == nil {
+		p.pestRepository = pestRepo.New(p.DBClient(ctx))
+	}
+	return p.pestRepository
+}
+
+func (p *serviceProvider) PestImagesRepository(ctx context.Context) repository.PestImagesRepository {
+	if p.pestImagesRepository == nil {
+		p.pestImagesRepository = pestImagesRepo.New(p.DBClient(ctx))
+	}
+	return p.pestImagesRepository
+}
+
+func (p *serviceProvider) PestRelationsRepository(ctx context.Context) repository.PestRelationsRepository {
+	if p.pestRelationsRepository == nil {
+		p.pestRelationsRepository = pestRelationsRepo.New(p.DBClient(ctx))
+	}
+	return p.pestRelationsRepository
+}
+
+func (p *serviceProvider) MediaImpl(ctx context.Context) *media.Implementation {
+	if p.mediaImpl == nil {
+		p.mediaImpl = media.New(p.MediaService(ctx), p.MediaConfig().MaxSize())
+	}
+	return p.mediaImpl
+}
+
+func (p *serviceProvider) MediaService(ctx context.Context) service.MediaService {
+	if p.mediaService == nil {
+		p.mediaService = mediaServ.New(
+			p.Logger(),
+			p.MediaRepository(ctx),
+			p.MediaVariantsRepository(ctx),
+			p.MediaStorage(),
+			p.TxManger(ctx),
+			p.MediaConfig().MaxSize(),
+		)
+	}
+	return p.mediaService
+}
+
+func (p *serviceProvider) MediaRepository(ctx context.Context) repository.MediaRepository {
+	if p.mediaRepository == nil {
+		p.mediaRepository = mediaRepo.New(p.DBClient(ctx))
+	}
+	return p.mediaRepository
+}
+
+func (p *serviceProvider) MediaVariantsRepository(ctx context.Context) repository.MediaVariantsRepository {
+	if p.mediaVariantsRepository == nil {
+		p.mediaVariantsRepository = mediaVariantsRepo.New(p.DBClient(ctx))
+	}
+	return p.mediaVariantsRepository
+}
+
+func (p *serviceProvider) MediaStorage() storage.Storage {
+	if p.mediaStorage == nil {
+		mediaStorage, err := local.New(p.MediaConfig().Dir(), p.MediaConfig().PublicURL())
+		if err != nil {
+			p.Logger().Error("failed to create media storage", slog.String("err", err.Error()))
+			panic(err)
+		}
+		p.mediaStorage = mediaStorage
+	}
+	return p.mediaStorage
+}
+
 func (p *serviceProvider) ArticleImpl(ctx context.Context) *article.Implementation {
 	if p.articlesImpl == nil {
 		p.articlesImpl = article.New(p.ArticleService(ctx))
@@ -281,8 +597,14 @@ func (p *serviceProvider) ArticleService(ctx context.Context) service.ArticleSer
 			p.Logger(),
 			p.ArticleRepository(ctx),
 			p.ArticleImagesRepository(ctx),
+			p.MediaVariantsRepository(ctx),
 			p.ArticleRelationsRepository(ctx),
+			p.ArticleRevisionsRepository(ctx),
 			p.StatusRepository(ctx),
+			p.StatusWorkflow(ctx),
+			p.AccessPolicy(),
+			p.AuditService(ctx),
+			p.SnapshotLoader(ctx),
 			p.TxManger(ctx),
 			p.AccessClient(),
 			p.AuthClient(),
@@ -292,6 +614,97 @@ func (p *serviceProvider) ArticleService(ctx context.Context) service.ArticleSer
 	return p.articleService
 }
 
+func (p *serviceProvider) ModerationImpl(ctx context.Context) *moderation.Implementation {
+	if p.moderationImpl == nil {
+		p.moderationImpl = moderation.New(p.ModerationService(ctx))
+	}
+	return p.moderationImpl
+}
+
+func (p *serviceProvider) ModerationService(ctx context.Context) service.ModerationService {
+	if p.moderationService == nil {
+		p.moderationService = moderationServ.New(
+			p.Logger(),
+			p.CropRepository(ctx),
+			p.CategoryRepository(ctx),
+			p.ArticleRepository(ctx),
+			p.PestRepository(ctx),
+			p.StatusRepository(ctx),
+			p.StatusWorkflow(ctx),
+			p.AuditService(ctx),
+			p.SnapshotLoader(ctx),
+			p.TxManger(ctx),
+			p.AccessClient(),
+			p.AuthClient(),
+			p.UserClient(),
+		)
+	}
+	return p.moderationService
+}
+
+func (p *serviceProvider) TrashImpl(ctx context.Context) *trash.Implementation {
+	if p.trashImpl == nil {
+		p.trashImpl = trash.New(p.TrashService(ctx))
+	}
+	return p.trashImpl
+}
+
+func (p *serviceProvider) TrashService(ctx context.Context) service.TrashService {
+	if p.trashService == nil {
+		p.trashService = trashServ.New(
+			p.Logger(),
+			p.TrashConfig().Retention(),
+			p.CropRepository(ctx),
+			p.CategoryRepository(ctx),
+			p.ArticleRepository(ctx),
+			p.PestRepository(ctx),
+			p.AccessPolicy(),
+			p.AuditService(ctx),
+			p.SnapshotLoader(ctx),
+			p.TxManger(ctx),
+			p.AccessClient(),
+			p.AuthClient(),
+			p.UserClient(),
+		)
+	}
+	return p.trashService
+}
+
+func (p *serviceProvider) AuditImpl(ctx context.Context) *audit.Implementation {
+	if p.auditImpl == nil {
+		p.auditImpl = audit.New(p.AuditService(ctx))
+	}
+	return p.auditImpl
+}
+
+func (p *serviceProvider) AuditService(ctx context.Context) service.AuditService {
+	if p.auditService == nil {
+		p.auditService = auditServ.New(
+			p.Logger(),
+			p.AuditLogRepository(ctx),
+			p.AccessClient(),
+			p.AuthClient(),
+		)
+	}
+	return p.auditService
+}
+
+func (p *serviceProvider) SnapshotLoader(ctx context.Context) service.SnapshotLoader {
+	if p.snapshotLoader == nil {
+		p.snapshotLoader = auditServ.NewSnapshotLoader(
+			p.CropRepository(ctx),
+			p.CategoryRepository(ctx),
+			p.ArticleRepository(ctx),
+			p.ArticleImagesRepository(ctx),
+			p.PestRepository(ctx),
+			p.PestImagesRepository(ctx),
+			p.MediaVariantsRepository(ctx),
+			p.StatusRepository(ctx),
+		)
+	}
+	return p.snapshotLoader
+}
+
 func (p *serviceProvider) ArticleRepository(ctx context.Context) repository.ArticleRepository {
 	if p.articleRepository == nil {
 		p.articleRepository = articleRepo.New(p.DBClient(ctx))
@@ -313,6 +726,13 @@ func (p *serviceProvider) ArticleRelationsRepository(ctx context.Context) reposi
 	return p.articleRelationsRepository
 }
 
+func (p *serviceProvider) ArticleRevisionsRepository(ctx context.Context) repository.ArticleRevisionsRepository {
+	if p.articleRevisionsRepository == nil {
+		p.articleRevisionsRepository = articleRevisionsRepo.New(p.DBClient(ctx))
+	}
+	return p.articleRevisionsRepository
+}
+
 func (p *serviceProvider) StatusRepository(ctx context.Context) repository.StatusRepository {
 	if p.statusRepository == nil {
 		p.statusRepository = statusRepo.New(p.DBClient(ctx))
@@ -320,6 +740,13 @@ func (p *serviceProvider) StatusRepository(ctx context.Context) repository.Statu
 	return p.statusRepository
 }
 
+func (p *serviceProvider) AuditLogRepository(ctx context.Context) repository.AuditLogRepository {
+	if p.auditLogRepository == nil {
+		p.auditLogRepository = auditLogRepo.New(p.DBClient(ctx))
+	}
+	return p.auditLogRepository
+}
+
 func (p *serviceProvider) DBClient(ctx context.Context) db.Client {
 	if p.dbClient == nil {
 		dbc, err := pg.New(ctx, p.PGConfig().DSN())
@@ -2,9 +2,12 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	grpclog "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	grpcretry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
+	"github.com/nogavadu/articles-service/internal/lib/identity"
+	"github.com/nogavadu/articles-service/internal/lib/jwt"
 	authService "github.com/nogavadu/auth-service/pkg/auth_v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -13,6 +16,8 @@ import (
 	"time"
 )
 
+var ErrMissingToken = errors.New("missing auth token")
+
 type AuthServiceClient struct {
 	api authService.AuthV1Client
 	log *slog.Logger
@@ -55,7 +60,7 @@ func NewAuthServiceClient(
 
 func InterceptorLogger(l *slog.Logger) grpclog.Logger {
 	return grpclog.LoggerFunc(func(ctx context.Context, lvl grpclog.Level, msg string, fields ...any) {
-		l.Log(ctx, slog.Level(lvl), msg, fields)
+		l.Log(ctx, slog.Level(lvl), msg, fields...)
 	})
 }
 
@@ -120,3 +125,28 @@ func (c *AuthServiceClient) IsUser(ctx context.Context, userId int) error {
 
 	return nil
 }
+
+// UserId returns the id of the user the request token belongs to, preferring the one already resolved by the auth middleware.
+// Otherwise the refresh token is exchanged first, so an invalid or expired token is rejected by auth-service.
+func (c *AuthServiceClient) UserId(ctx context.Context) (int, error) {
+	const op = "AuthServiceClient.UserId"
+
+	if userId, ok := identity.UserId(ctx); ok {
+		return userId, nil
+	}
+	if _, ok := ctx.Value("authorization").(string); !ok {
+		return 0, fmt.Errorf("%s: %w", op, ErrMissingToken)
+	}
+
+	accessToken, err := c.AccessToken(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	claims, err := jwt.ParseUnverified(accessToken)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return claims.Id, nil
+}
@@ -13,9 +13,12 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"log/slog"
+	"sync"
 	"time"
 )
 
+const maxConcurrentCalls = 16
+
 type UserServiceClient struct {
 	api userService.UserV1Client
 	log *slog.Logger
@@ -75,6 +78,52 @@ func (c *UserServiceClient) GetById(ctx context.Context, userId int) (*model.Use
 	}, nil
 }
 
+// GetByIds resolves a set of users with one call per distinct id, since user_v1 has no batch method.
+// Calls run concurrently and a failed call doesn't stop the others, users that failed to load are missing
+// from the map and the first error is returned.
+func (c *UserServiceClient) GetByIds(ctx context.Context, userIds []int) (map[int]*model.User, error) {
+	const op = "UserServiceClient.GetByIds"
+
+	unique := make(map[int]struct{}, len(userIds))
+	for _, id := range userIds {
+		unique[id] = struct{}{}
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		users    = make(map[int]*model.User, len(unique))
+		sem      = make(chan struct{}, maxConcurrentCalls)
+	)
+
+	for id := range unique {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			user, err := c.GetById(ctx, id)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("%s: user %d: %w", op, id, err)
+				}
+				return
+			}
+			users[id] = user
+		}()
+	}
+	wg.Wait()
+
+	return users, firstErr
+}
+
 func (c *UserServiceClient) Update(ctx context.Context, userId int, updateInput *model.UserUpdateInput) error {
 	_, err := c.api.Update(ctx, &userService.UpdateRequest{
 		Id: int64(userId),
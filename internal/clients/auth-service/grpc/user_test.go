@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	userService "github.com/nogavadu/auth-service/pkg/user_v1"
+	"google.golang.org/grpc"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// userApi answers GetById for every id except the missing ones and records how many calls overlap.
+type userApi struct {
+	userService.UserV1Client
+
+	missing map[int64]bool
+
+	mu       sync.Mutex
+	calls    map[int64]int
+	inFlight atomic.Int32
+	peak     atomic.Int32
+}
+
+func (a *userApi) GetById(ctx context.Context, in *userService.GetByIdRequest, _ ...grpc.CallOption) (*userService.GetByIdResponse, error) {
+	n := a.inFlight.Add(1)
+	defer a.inFlight.Add(-1)
+	for {
+		peak := a.peak.Load()
+		if n <= peak || a.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+
+	a.mu.Lock()
+	a.calls[in.GetId()]++
+	a.mu.Unlock()
+
+	time.Sleep(time.Millisecond)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if a.missing[in.GetId()] {
+		return nil, errors.New("user not found")
+	}
+
+	return &userService.GetByIdResponse{User: &userService.User{Id: in.GetId()}}, nil
+}
+
+func TestGetByIds(t *testing.T) {
+	api := &userApi{missing: map[int64]bool{3: true}, calls: map[int64]int{}}
+	client := &UserServiceClient{api: api}
+
+	ids := []int{1, 2, 3, 2, 1}
+	for id := 4; id <= 40; id++ {
+		ids = append(ids, id)
+	}
+
+	users, err := client.GetByIds(context.Background(), ids)
+	if err == nil {
+		t.Error("GetByIds() error = nil, want the failed lookup")
+	}
+
+	if len(users) != 39 {
+		t.Errorf("GetByIds() loaded %d users, want 39", len(users))
+	}
+	for id := 1; id <= 40; id++ {
+		if _, ok := users[id]; ok == (id == 3) {
+			t.Errorf("GetByIds() user %d loaded = %t", id, ok)
+		}
+	}
+
+	for id, n := range api.calls {
+		if n != 1 {
+			t.Errorf("user %d requested %d times, want once", id, n)
+		}
+	}
+	if peak := api.peak.Load(); peak > maxConcurrentCalls {
+		t.Errorf("%d concurrent calls, want at most %d", peak, maxConcurrentCalls)
+	}
+}
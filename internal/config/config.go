@@ -11,9 +11,25 @@ type HTTPServerConfig interface {
 	Address() string
 }
 
+type GRPCServerConfig interface {
+	Port() int
+	Address() string
+}
+
 type AuthServiceConfig interface {
 	Address() string
 	Timeout() time.Duration
 	RetriesCount() int
 	Insecure() bool
 }
+
+type TrashConfig interface {
+	Retention() time.Duration
+	PurgeInterval() time.Duration
+}
+
+type MediaConfig interface {
+	Dir() string
+	PublicURL() string
+	MaxSize() int64
+}
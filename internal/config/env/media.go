@@ -0,0 +1,69 @@
+package env
+
+import (
+	"fmt"
+	"github.com/nogavadu/articles-service/internal/config"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	mediaDirEnv       = "MEDIA_DIR"
+	mediaPublicURLEnv = "MEDIA_PUBLIC_URL"
+	mediaMaxSizeEnv   = "MEDIA_MAX_SIZE"
+
+	defaultMediaDir       = "./media"
+	defaultMediaPublicURL = "/api/media/files"
+	defaultMediaMaxSize   = 10 << 20
+)
+
+type mediaConfig struct {
+	dir       string
+	publicURL string
+	maxSize   int64
+}
+
+// NewMediaConfig reads where uploads are stored and served from, all variables are optional.
+func NewMediaConfig() (config.MediaConfig, error) {
+	const op = "config.NewMediaConfig"
+
+	dir := os.Getenv(mediaDirEnv)
+	if dir == "" {
+		dir = defaultMediaDir
+	}
+
+	publicURL := strings.TrimSuffix(os.Getenv(mediaPublicURLEnv), "/")
+	if publicURL == "" {
+		publicURL = defaultMediaPublicURL
+	}
+
+	maxSize := int64(defaultMediaMaxSize)
+	if value := os.Getenv(mediaMaxSizeEnv); value != "" {
+		size, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || size <= 0 {
+			return nil, fmt.Errorf("%s: %s: invalid env variable", op, mediaMaxSizeEnv)
+		}
+		maxSize = size
+	}
+
+	return &mediaConfig{
+		dir:       dir,
+		publicURL: publicURL,
+		maxSize:   maxSize,
+	}, nil
+}
+
+func (c *mediaConfig) Dir() string {
+	return c.dir
+}
+
+// PublicURL is the prefix the stored file keys are appended to.
+func (c *mediaConfig) PublicURL() string {
+	return c.publicURL
+}
+
+// MaxSize is the largest accepted upload in bytes.
+func (c *mediaConfig) MaxSize() int64 {
+	return c.maxSize
+}
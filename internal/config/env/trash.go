@@ -0,0 +1,63 @@
+package env
+
+import (
+	"fmt"
+	"github.com/nogavadu/articles-service/internal/config"
+	"os"
+	"time"
+)
+
+const (
+	trashRetentionEnv     = "TRASH_RETENTION"
+	trashPurgeIntervalEnv = "TRASH_PURGE_INTERVAL"
+
+	defaultTrashRetention     = 30 * 24 * time.Hour
+	defaultTrashPurgeInterval = time.Hour
+)
+
+type trashConfig struct {
+	retention     time.Duration
+	purgeInterval time.Duration
+}
+
+// NewTrashConfig reads how long trashed items are kept, both variables are optional.
+func NewTrashConfig() (config.TrashConfig, error) {
+	const op = "config.NewTrashConfig"
+
+	retention, err := durationEnv(trashRetentionEnv, defaultTrashRetention)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	purgeInterval, err := durationEnv(trashPurgeIntervalEnv, defaultTrashPurgeInterval)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &trashConfig{
+		retention:     retention,
+		purgeInterval: purgeInterval,
+	}, nil
+}
+
+func (c *trashConfig) Retention() time.Duration {
+	return c.retention
+}
+
+func (c *trashConfig) PurgeInterval() time.Duration {
+	return c.purgeInterval
+}
+
+func durationEnv(key string, def time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return def, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("%s: invalid env variable", key)
+	}
+
+	return d, nil
+}
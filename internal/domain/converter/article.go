@@ -1,21 +1,36 @@
 package converter
 
 import (
+	"encoding/json"
 	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/markup"
+	"github.com/nogavadu/articles-service/internal/lib/pagination"
 	repoModel "github.com/nogavadu/articles-service/internal/repository/article/model"
+	imagesRepoModel "github.com/nogavadu/articles-service/internal/repository/article_images/model"
+	relationsRepoModel "github.com/nogavadu/articles-service/internal/repository/article_relations/model"
+	"strconv"
+	"time"
 )
 
-func ToArticle(article *repoModel.Article, images []string, status string, author *model.User) *model.Article {
+func ToArticle(article *repoModel.Article, images []model.ArticleImage, status string, author *model.User) *model.Article {
 	return &model.Article{
-		Id:          article.Id,
-		ArticleBody: *ToArticleBody(article, images, status, author),
+		Id:              article.Id,
+		ArticleBody:     *ToArticleBody(article, images, status, author),
+		RejectionReason: article.RejectionReason,
+		CreatedAt:       article.CreatedAt,
+		UpdatedAt:       article.UpdatedAt,
+		Rank:            article.Rank,
+		Snippet:         article.Snippet,
 	}
 }
 
-func ToArticleBody(article *repoModel.Article, images []string, status string, author *model.User) *model.ArticleBody {
+func ToArticleBody(article *repoModel.Article, images []model.ArticleImage, status string, author *model.User) *model.ArticleBody {
 	return &model.ArticleBody{
 		Title:     article.Title,
 		Text:      article.Text,
+		Format:    article.Format,
+		Html:      article.Html,
+		Toc:       toArticleToc(article.Toc),
 		LatinName: article.LatinName,
 		Images:    images,
 		Author:    author,
@@ -23,21 +38,136 @@ func ToArticleBody(article *repoModel.Article, images []string, status string, a
 	}
 }
 
+func ToArticleToc(headings []markup.Heading) []model.ArticleTocEntry {
+	if len(headings) == 0 {
+		return nil
+	}
+
+	toc := make([]model.ArticleTocEntry, 0, len(headings))
+	for _, heading := range headings {
+		toc = append(toc, model.ArticleTocEntry{
+			Level:  heading.Level,
+			Text:   heading.Text,
+			Anchor: heading.Anchor,
+		})
+	}
+
+	return toc
+}
+
+// toArticleToc decodes the stored toc, it is written by the service so a malformed one is treated as missing.
+func toArticleToc(data []byte) []model.ArticleTocEntry {
+	if len(data) == 0 {
+		return nil
+	}
+
+	var toc []model.ArticleTocEntry
+	if err := json.Unmarshal(data, &toc); err != nil {
+		return nil
+	}
+	return toc
+}
+
+// ToArticleImages attaches the variants loaded by media id, variants may be nil when they aren't needed.
+func ToArticleImages(images []imagesRepoModel.Image, variants map[int]model.ImageVariants) []model.ArticleImage {
+	if len(images) == 0 {
+		return nil
+	}
+
+	res := make([]model.ArticleImage, 0, len(images))
+	for _, img := range images {
+		image := model.ArticleImage{
+			Id:       img.Id,
+			MediaId:  img.MediaId,
+			Image:    model.Image{Url: img.Img},
+			Position: img.Position,
+			ArticleImageDetails: model.ArticleImageDetails{
+				Caption:     img.Caption,
+				Alt:         img.Alt,
+				Attribution: img.Attribution,
+			},
+		}
+		if img.MediaId != nil {
+			image.Variants = variants[*img.MediaId]
+		}
+		res = append(res, image)
+	}
+
+	return res
+}
+
+func ToRepoArticleImageInfo(input *model.ArticleImageInput) *imagesRepoModel.ImageInfo {
+	return &imagesRepoModel.ImageInfo{
+		MediaId:     &input.MediaId,
+		Caption:     input.Caption,
+		Alt:         input.Alt,
+		Attribution: input.Attribution,
+	}
+}
+
+func ToRepoArticleImageUpdateInput(input *model.ArticleImageDetails) *imagesRepoModel.UpdateInput {
+	return &imagesRepoModel.UpdateInput{
+		Caption:     input.Caption,
+		Alt:         input.Alt,
+		Attribution: input.Attribution,
+	}
+}
+
+func ToArticleRelations(relations []relationsRepoModel.Relation) []model.ArticleRelation {
+	res := make([]model.ArticleRelation, 0, len(relations))
+	for _, relation := range relations {
+		res = append(res, model.ArticleRelation{
+			CropId:     relation.CropId,
+			CategoryId: relation.CategoryId,
+		})
+	}
+
+	return res
+}
+
+func ToInconsistentArticleRelations(relations []relationsRepoModel.ArticleRelation) []model.InconsistentArticleRelation {
+	res := make([]model.InconsistentArticleRelation, 0, len(relations))
+	for _, relation := range relations {
+		res = append(res, model.InconsistentArticleRelation{
+			ArticleId: relation.ArticleId,
+			ArticleRelation: model.ArticleRelation{
+				CropId:     relation.CropId,
+				CategoryId: relation.CategoryId,
+			},
+		})
+	}
+
+	return res
+}
+
 func ToRepoArticleBody(body *model.ArticleBody, status int, author int) *repoModel.ArticleBody {
 	return &repoModel.ArticleBody{
 		Title:     body.Title,
 		LatinName: body.LatinName,
 		Text:      body.Text,
+		Format:    body.Format,
 		Status:    status,
 		Author:    &author,
 	}
 }
 
-func ToRepoArticleGetAllParams(params *model.ArticleGetAllParams, status int) *repoModel.ArticleGetAllParams {
+func ToRepoArticleGetAllParams(
+	params *model.ArticleGetAllParams,
+	status int,
+	cursor *repoModel.ArticleCursor,
+) *repoModel.ArticleGetAllParams {
 	return &repoModel.ArticleGetAllParams{
 		CropId:     params.CropId,
 		CategoryId: params.CategoryId,
 		Status:     status,
+		Query:      params.Query,
+		Limit:      uint64(params.Limit),
+
+		IncludeDescendants: params.IncludeDescendants,
+		IncludeParentCrops: params.IncludeParentCrops,
+		Sort:               params.Sort,
+		Desc:               params.Desc,
+		Cursor:             cursor,
 	}
 }
 
@@ -46,6 +176,57 @@ func ToRepoArticleUpdateInput(input *model.ArticleUpdateInput, statusId *int) *r
 		Title:     input.Title,
 		LatinName: input.LatinName,
 		Text:      input.Text,
+		Format:    input.Format,
 		Status:    statusId,
+
+		ClearLatinName: input.ClearLatinName,
+		ClearText:      input.ClearText,
+	}
+}
+
+func ToRepoArticleCursor(cursor *pagination.Cursor, sort string) (*repoModel.ArticleCursor, error) {
+	var value interface{} = cursor.Value
+	switch sort {
+	case model.ArticleSortCreatedAt, model.ArticleSortUpdatedAt:
+		t, err := time.Parse(time.RFC3339Nano, cursor.Value)
+		if err != nil {
+			return nil, pagination.ErrInvalidCursor
+		}
+		value = t
+	case model.ArticleSortRank:
+		rank, err := strconv.ParseFloat(cursor.Value, 32)
+		if err != nil {
+			return nil, pagination.ErrInvalidCursor
+		}
+		value = float32(rank)
+	}
+
+	return &repoModel.ArticleCursor{
+		Value: value,
+		Id:    cursor.Id,
+	}, nil
+}
+
+func ToArticleCursor(article *repoModel.Article, params *model.ArticleGetAllParams) *pagination.Cursor {
+	var value string
+	switch params.Sort {
+	case model.ArticleSortTitle:
+		value = article.Title
+	case model.ArticleSortRank:
+		if article.Rank != nil {
+			value = strconv.FormatFloat(float64(*article.Rank), 'g', -1, 32)
+		}
+	case model.ArticleSortUpdatedAt:
+		value = article.UpdatedAt.Format(time.RFC3339Nano)
+	default:
+		value = article.CreatedAt.Format(time.RFC3339Nano)
+	}
+
+	return &pagination.Cursor{
+		Value: value,
+		Id:    article.Id,
+		Sort:  params.Sort,
+		Desc:  params.Desc,
+		Query: pagination.QueryHash(params.Query),
 	}
 }
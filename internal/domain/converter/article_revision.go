@@ -0,0 +1,43 @@
+package converter
+
+import (
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	articleRepoModel "github.com/nogavadu/articles-service/internal/repository/article/model"
+	repoModel "github.com/nogavadu/articles-service/internal/repository/article_revisions/model"
+)
+
+func ToArticleRevision(revision *repoModel.Revision, author *model.User) *model.ArticleRevision {
+	return &model.ArticleRevision{
+		Id:        revision.Id,
+		ArticleId: revision.ArticleId,
+		Title:     revision.Title,
+		LatinName: revision.LatinName,
+		Text:      revision.Text,
+		Format:    revision.Format,
+		Author:    author,
+		CreatedAt: revision.CreatedAt,
+	}
+}
+
+func ToRepoRevisionInfo(article *articleRepoModel.Article, author *int) *repoModel.RevisionInfo {
+	return &repoModel.RevisionInfo{
+		ArticleId: article.Id,
+		Title:     article.Title,
+		LatinName: article.LatinName,
+		Text:      article.Text,
+		Format:    article.Format,
+		Author:    author,
+	}
+}
+
+// RevisionToArticleUpdateInput sets every content field, so fields missing in the revision are cleared.
+func RevisionToArticleUpdateInput(revision *repoModel.Revision) *model.ArticleUpdateInput {
+	return &model.ArticleUpdateInput{
+		Title:          &revision.Title,
+		LatinName:      revision.LatinName,
+		Text:           revision.Text,
+		Format:         &revision.Format,
+		ClearLatinName: revision.LatinName == nil,
+		ClearText:      revision.Text == nil,
+	}
+}
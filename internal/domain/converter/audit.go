@@ -0,0 +1,30 @@
+package converter
+
+import (
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	repoModel "github.com/nogavadu/articles-service/internal/repository/audit_log/model"
+)
+
+func ToAuditEntry(entry *repoModel.Entry) *model.AuditEntry {
+	return &model.AuditEntry{
+		Id:         entry.Id,
+		Actor:      entry.Actor,
+		EntityType: entry.EntityType,
+		EntityId:   entry.EntityId,
+		Action:     entry.Action,
+		Before:     entry.Before,
+		After:      entry.After,
+		CreatedAt:  entry.CreatedAt,
+	}
+}
+
+func ToRepoAuditGetAllParams(params *model.AuditGetAllParams) *repoModel.GetAllParams {
+	return &repoModel.GetAllParams{
+		EntityType: params.EntityType,
+		EntityId:   params.EntityId,
+		Actor:      params.Actor,
+		From:       params.From,
+		To:         params.To,
+		Limit:      uint64(params.Limit),
+	}
+}
@@ -0,0 +1,64 @@
+package converter
+
+import (
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	repoModel "github.com/nogavadu/articles-service/internal/repository/crop_calendar/model"
+)
+
+func ToCalendarEntry(entry *repoModel.Entry) *model.CalendarEntry {
+	return &model.CalendarEntry{
+		Id:     entry.Id,
+		CropId: entry.CropId,
+		CalendarEntryInfo: model.CalendarEntryInfo{
+			Activity:   entry.Activity,
+			Zone:       entry.Zone,
+			StartMonth: entry.StartMonth,
+			EndMonth:   entry.EndMonth,
+			StartWeek:  entry.StartWeek,
+			EndWeek:    entry.EndWeek,
+			Notes:      entry.Notes,
+		},
+		CreatedAt: entry.CreatedAt,
+		UpdatedAt: entry.UpdatedAt,
+	}
+}
+
+func ToCalendarZoneEntry(entry *repoModel.ZoneEntry) *model.CalendarZoneEntry {
+	return &model.CalendarZoneEntry{
+		CalendarEntry: *ToCalendarEntry(&entry.Entry),
+		CropName:      entry.CropName,
+	}
+}
+
+func ToRepoCalendarEntryInfo(info *model.CalendarEntryInfo) *repoModel.EntryInfo {
+	return &repoModel.EntryInfo{
+		Activity:   info.Activity,
+		Zone:       info.Zone,
+		StartMonth: info.StartMonth,
+		EndMonth:   info.EndMonth,
+		StartWeek:  info.StartWeek,
+		EndWeek:    info.EndWeek,
+		Notes:      info.Notes,
+	}
+}
+
+func ToRepoCalendarUpdateInput(input *model.UpdateCalendarEntryInput) *repoModel.UpdateInput {
+	return &repoModel.UpdateInput{
+		Activity:   input.Activity,
+		Zone:       input.Zone,
+		StartMonth: input.StartMonth,
+		EndMonth:   input.EndMonth,
+		StartWeek:  input.StartWeek,
+		EndWeek:    input.EndWeek,
+		Notes:      input.Notes,
+	}
+}
+
+func ToRepoCalendarZoneParams(params *model.CalendarZoneParams, cropStatus int) *repoModel.ZoneParams {
+	return &repoModel.ZoneParams{
+		Zone:       params.Zone,
+		Month:      params.Month,
+		Week:       params.Week,
+		CropStatus: cropStatus,
+	}
+}
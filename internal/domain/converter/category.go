@@ -7,8 +7,11 @@ import (
 
 func ToCategory(category *repoModel.Category, status string, author *model.User) *model.Category {
 	return &model.Category{
-		ID:           category.ID,
-		CategoryInfo: *ToCategoryInfo(category, status, author),
+		ID:              category.ID,
+		CategoryInfo:    *ToCategoryInfo(category, status, author),
+		RejectionReason: category.RejectionReason,
+		CreatedAt:       category.CreatedAt,
+		UpdatedAt:       category.UpdatedAt,
 	}
 }
 
@@ -17,8 +20,11 @@ func ToCategoryInfo(category *repoModel.Category, status string, author *model.U
 		Name:        category.Name,
 		Description: category.Description,
 		Icon:        category.Icon,
+		IconId:      category.IconId,
 		Status:      status,
 		Author:      author,
+		ParentId:    category.ParentId,
+		Position:    category.Position,
 	}
 }
 
@@ -26,8 +32,11 @@ func ToRepoCategoryInfo(categoryInfo *model.CategoryInfo, status int, author int
 	return &repoModel.CategoryInfo{
 		Name:        categoryInfo.Name,
 		Description: categoryInfo.Description,
+		IconId:      categoryInfo.IconId,
 		Status:      status,
 		Author:      &author,
+		ParentId:    categoryInfo.ParentId,
+		Position:    categoryInfo.Position,
 	}
 }
 
@@ -42,7 +51,7 @@ func ToRepoCategoryUpdateInput(input *model.UpdateCategoryInput, statusId *int)
 	return &repoModel.UpdateInput{
 		Name:        input.Name,
 		Description: input.Description,
-		Icon:        input.Icon,
+		IconId:      input.IconId,
 		Status:      statusId,
 	}
 }
@@ -0,0 +1,40 @@
+package converter
+
+import (
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	repoModel "github.com/nogavadu/articles-service/internal/repository/crop_companions/model"
+)
+
+func ToCropCompanion(companion *repoModel.Companion) *model.CropCompanion {
+	return &model.CropCompanion{
+		CompanionId:       companion.CompanionId,
+		CompanionName:     companion.CompanionName,
+		CropCompanionInfo: *ToCropCompanionInfo(&companion.CompanionInfo),
+		CreatedAt:         companion.CreatedAt,
+		UpdatedAt:         companion.UpdatedAt,
+	}
+}
+
+func ToCropCompanionInfo(info *repoModel.CompanionInfo) *model.CropCompanionInfo {
+	return &model.CropCompanionInfo{
+		Type: info.Type,
+		Note: info.Note,
+	}
+}
+
+func ToRepoCompanionInfo(info *model.CropCompanionInfo) *repoModel.CompanionInfo {
+	return &repoModel.CompanionInfo{
+		Type: info.Type,
+		Note: info.Note,
+	}
+}
+
+func ToBedConflict(conflict *repoModel.Conflict) *model.BedConflict {
+	return &model.BedConflict{
+		CropId:        conflict.CropId,
+		CropName:      conflict.CropName,
+		CompanionId:   conflict.CompanionId,
+		CompanionName: conflict.CompanionName,
+		Note:          conflict.Note,
+	}
+}
@@ -7,10 +7,11 @@ import (
 
 func ToCrop(crop *repoModel.Crop, status string, author *model.User) *model.Crop {
 	return &model.Crop{
-		ID:        crop.ID,
-		CropInfo:  *ToCropInfo(&crop.CropInfo, status, author),
-		CreatedAt: crop.CreatedAt,
-		UpdatedAt: crop.UpdatedAt,
+		ID:              crop.ID,
+		CropInfo:        *ToCropInfo(&crop.CropInfo, status, author),
+		RejectionReason: crop.RejectionReason,
+		CreatedAt:       crop.CreatedAt,
+		UpdatedAt:       crop.UpdatedAt,
 	}
 }
 
@@ -19,8 +20,13 @@ func ToCropInfo(cropInfo *repoModel.CropInfo, status string, author *model.User)
 		Name:        cropInfo.Name,
 		Description: cropInfo.Description,
 		Img:         cropInfo.Img,
+		ImgId:       cropInfo.ImgId,
 		Status:      status,
 		Author:      author,
+		ParentId:    cropInfo.ParentId,
+		Family:      cropInfo.Family,
+		Genus:       cropInfo.Genus,
+		Species:     cropInfo.Species,
 	}
 }
 
@@ -28,9 +34,13 @@ func ToRepoCropInfo(info *model.CropInfo, statusId int, authorId int) *repoModel
 	return &repoModel.CropInfo{
 		Name:        info.Name,
 		Description: info.Description,
-		Img:         info.Img,
+		ImgId:       info.ImgId,
 		Status:      statusId,
 		Author:      &authorId,
+		ParentId:    info.ParentId,
+		Family:      info.Family,
+		Genus:       info.Genus,
+		Species:     info.Species,
 	}
 }
 
@@ -38,7 +48,10 @@ func ToRepoCropUpdateInput(input *model.UpdateCropInput, statusId *int) *repoMod
 	return &repoModel.UpdateInput{
 		Name:        input.Name,
 		Description: input.Description,
-		Img:         input.Img,
+		ImgId:       input.ImgId,
 		Status:      statusId,
+		Family:      input.Family,
+		Genus:       input.Genus,
+		Species:     input.Species,
 	}
 }
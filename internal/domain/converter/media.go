@@ -0,0 +1,48 @@
+package converter
+
+import (
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	repoModel "github.com/nogavadu/articles-service/internal/repository/media/model"
+	variantRepoModel "github.com/nogavadu/articles-service/internal/repository/media_variants/model"
+)
+
+func ToMedia(media *repoModel.Media, variants []variantRepoModel.Variant) *model.Media {
+	return &model.Media{
+		Id:          media.Id,
+		Url:         media.Url,
+		ContentType: media.ContentType,
+		Size:        media.Size,
+		Checksum:    media.Checksum,
+		Width:       media.Width,
+		Height:      media.Height,
+		Variants:    ToImageVariants(variants),
+		CreatedAt:   media.CreatedAt,
+	}
+}
+
+func ToImageVariants(variants []variantRepoModel.Variant) model.ImageVariants {
+	if len(variants) == 0 {
+		return nil
+	}
+
+	res := make(model.ImageVariants, len(variants))
+	for _, v := range variants {
+		res[v.Name] = model.ImageVariant{
+			Url:    v.Url,
+			Width:  v.Width,
+			Height: v.Height,
+		}
+	}
+
+	return res
+}
+
+// ToMediaImageVariants converts the variants of several media loaded at once.
+func ToMediaImageVariants(variants map[int][]variantRepoModel.Variant) map[int]model.ImageVariants {
+	res := make(map[int]model.ImageVariants, len(variants))
+	for mediaId, v := range variants {
+		res[mediaId] = ToImageVariants(v)
+	}
+
+	return res
+}
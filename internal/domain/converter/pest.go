@@ -0,0 +1,76 @@
+package converter
+
+import (
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	repoModel "github.com/nogavadu/articles-service/internal/repository/pest/model"
+	relationsRepoModel "github.com/nogavadu/articles-service/internal/repository/pest_relations/model"
+)
+
+func ToPest(pest *repoModel.Pest, images []string, status string, author *model.User) *model.Pest {
+	return &model.Pest{
+		ID:              pest.ID,
+		PestInfo:        *ToPestInfo(&pest.PestInfo, images, status, author),
+		RejectionReason: pest.RejectionReason,
+		CreatedAt:       pest.CreatedAt,
+		UpdatedAt:       pest.UpdatedAt,
+	}
+}
+
+func ToPestInfo(info *repoModel.PestInfo, images []string, status string, author *model.User) *model.PestInfo {
+	return &model.PestInfo{
+		Name:        info.Name,
+		LatinName:   info.LatinName,
+		Type:        info.Type,
+		Symptoms:    info.Symptoms,
+		Description: info.Description,
+		Images:      images,
+		Status:      status,
+		Author:      author,
+	}
+}
+
+func ToRepoPestInfo(info *model.PestInfo, statusId int, authorId int) *repoModel.PestInfo {
+	return &repoModel.PestInfo{
+		Name:        info.Name,
+		LatinName:   info.LatinName,
+		Type:        info.Type,
+		Symptoms:    info.Symptoms,
+		Description: info.Description,
+		Status:      statusId,
+		Author:      &authorId,
+	}
+}
+
+func ToRepoPestUpdateInput(input *model.UpdatePestInput, statusId *int) *repoModel.UpdateInput {
+	return &repoModel.UpdateInput{
+		Name:        input.Name,
+		LatinName:   input.LatinName,
+		Type:        input.Type,
+		Symptoms:    input.Symptoms,
+		Description: input.Description,
+		Status:      statusId,
+	}
+}
+
+func ToRepoPestGetAllParams(params *model.PestGetAllParams, statusId int) *repoModel.PestGetAllParams {
+	return &repoModel.PestGetAllParams{
+		Status: statusId,
+		CropId: params.CropId,
+	}
+}
+
+func ToPestCrops(crops []relationsRepoModel.Crop) []model.PestCrop {
+	res := make([]model.PestCrop, 0, len(crops))
+	for _, c := range crops {
+		res = append(res, model.PestCrop{Id: c.Id, Name: c.Name})
+	}
+	return res
+}
+
+func ToPestArticles(articles []relationsRepoModel.Article) []model.PestArticle {
+	res := make([]model.PestArticle, 0, len(articles))
+	for _, a := range articles {
+		res = append(res, model.PestArticle{Id: a.Id, Title: a.Title})
+	}
+	return res
+}
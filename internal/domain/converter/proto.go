@@ -1,6 +1,13 @@
 package converter
 
-import "google.golang.org/protobuf/types/known/wrapperspb"
+import (
+	"errors"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"strconv"
+)
+
+// ErrInvalidMediaId is returned for image fields that don't hold a media id.
+var ErrInvalidMediaId = errors.New("image fields must hold uploaded media ids")
 
 func ProtoStringToPtrString(s *wrapperspb.StringValue) *string {
 	if s == nil {
@@ -16,3 +23,46 @@ func StringPtrToProtoString(ptr *string) *wrapperspb.StringValue {
 	}
 	return wrapperspb.String(*ptr)
 }
+
+// ProtoStringToPtrMediaId reads a media id from an image field, the proto keeps them as strings
+// since the same fields carry the resolved urls in responses. An empty string stands for 0.
+func ProtoStringToPtrMediaId(s *wrapperspb.StringValue) (*int, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	id := 0
+	if s.GetValue() != "" {
+		var err error
+		if id, err = parseMediaId(s.GetValue()); err != nil {
+			return nil, err
+		}
+	}
+
+	return &id, nil
+}
+
+func ProtoStringsToMediaIds(values []string) ([]int, error) {
+	if values == nil {
+		return nil, nil
+	}
+
+	ids := make([]int, 0, len(values))
+	for _, value := range values {
+		id, err := parseMediaId(value)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func parseMediaId(value string) (int, error) {
+	id, err := strconv.Atoi(value)
+	if err != nil || id <= 0 {
+		return 0, ErrInvalidMediaId
+	}
+	return id, nil
+}
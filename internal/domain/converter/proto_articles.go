@@ -0,0 +1,225 @@
+package converter
+
+import (
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	desc "github.com/nogavadu/articles-service/pkg/articles_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func ToProtoUser(user *model.User) *desc.User {
+	if user == nil {
+		return nil
+	}
+	return &desc.User{
+		Id:     int64(user.Id),
+		Name:   StringPtrToProtoString(user.Name),
+		Email:  user.Email,
+		Avatar: StringPtrToProtoString(user.Avatar),
+		Role:   user.Role,
+	}
+}
+
+func ToProtoCrop(crop *model.Crop) *desc.Crop {
+	return &desc.Crop{
+		Id: int64(crop.ID),
+		Info: &desc.CropInfo{
+			Name:        crop.Name,
+			Description: StringPtrToProtoString(crop.Description),
+			Img:         StringPtrToProtoString(crop.Img),
+			Status:      crop.Status,
+		},
+		Author:          ToProtoUser(crop.Author),
+		RejectionReason: StringPtrToProtoString(crop.RejectionReason),
+		CreatedAt:       timestamppb.New(crop.CreatedAt),
+		UpdatedAt:       timestamppb.New(crop.UpdatedAt),
+	}
+}
+
+func ProtoToCropInfo(info *desc.CropInfo) (*model.CropInfo, error) {
+	imgId, err := ProtoStringToPtrMediaId(info.GetImg())
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.CropInfo{
+		Name:        info.GetName(),
+		Description: ProtoStringToPtrString(info.GetDescription()),
+		ImgId:       nilIfZero(imgId),
+		Status:      info.GetStatus(),
+	}, nil
+}
+
+func ProtoToUpdateCropInput(req *desc.UpdateCropRequest) (*model.UpdateCropInput, error) {
+	imgId, err := ProtoStringToPtrMediaId(req.GetImg())
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.UpdateCropInput{
+		Name:        ProtoStringToPtrString(req.GetName()),
+		Description: ProtoStringToPtrString(req.GetDescription()),
+		ImgId:       imgId,
+		Status:      ProtoStringToPtrString(req.GetStatus()),
+	}, nil
+}
+
+// ToProtoCategories flattens the category tree depth first, the proto message has no children.
+func ToProtoCategories(categories []model.Category) []*desc.Category {
+	res := make([]*desc.Category, 0, len(categories))
+	for idx := range categories {
+		res = append(res, ToProtoCategory(&categories[idx]))
+		res = append(res, ToProtoCategories(categories[idx].Children)...)
+	}
+
+	return res
+}
+
+func ToProtoCategory(category *model.Category) *desc.Category {
+	return &desc.Category{
+		Id: int64(category.ID),
+		Info: &desc.CategoryInfo{
+			Name:        category.Name,
+			Description: StringPtrToProtoString(category.Description),
+			Icon:        StringPtrToProtoString(category.Icon),
+			Status:      category.Status,
+		},
+		Author:          ToProtoUser(category.Author),
+		RejectionReason: StringPtrToProtoString(category.RejectionReason),
+		CreatedAt:       timestamppb.New(category.CreatedAt),
+		UpdatedAt:       timestamppb.New(category.UpdatedAt),
+	}
+}
+
+func ProtoToCategoryInfo(info *desc.CategoryInfo) (*model.CategoryInfo, error) {
+	iconId, err := ProtoStringToPtrMediaId(info.GetIcon())
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.CategoryInfo{
+		Name:        info.GetName(),
+		Description: ProtoStringToPtrString(info.GetDescription()),
+		IconId:      nilIfZero(iconId),
+		Status:      info.GetStatus(),
+	}, nil
+}
+
+func ProtoToUpdateCategoryInput(req *desc.UpdateCategoryRequest) (*model.UpdateCategoryInput, error) {
+	iconId, err := ProtoStringToPtrMediaId(req.GetIcon())
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.UpdateCategoryInput{
+		Name:        ProtoStringToPtrString(req.GetName()),
+		Description: ProtoStringToPtrString(req.GetDescription()),
+		IconId:      iconId,
+		Status:      ProtoStringToPtrString(req.GetStatus()),
+	}, nil
+}
+
+func ToProtoArticle(article *model.Article) *desc.Article {
+	var rank *wrapperspb.FloatValue
+	if article.Rank != nil {
+		rank = wrapperspb.Float(*article.Rank)
+	}
+
+	return &desc.Article{
+		Id: int64(article.Id),
+		Body: &desc.ArticleBody{
+			Title:     article.Title,
+			LatinName: StringPtrToProtoString(article.LatinName),
+			Text:      StringPtrToProtoString(article.Text),
+			Format:    article.Format,
+			Html:      StringPtrToProtoString(article.Html),
+			Toc:       ToProtoArticleToc(article.Toc),
+			Images:    ToImageUrls(article.Images),
+			Status:    article.Status,
+		},
+		Author:          ToProtoUser(article.Author),
+		RejectionReason: StringPtrToProtoString(article.RejectionReason),
+		CreatedAt:       timestamppb.New(article.CreatedAt),
+		UpdatedAt:       timestamppb.New(article.UpdatedAt),
+		Rank:            rank,
+		Snippet:         StringPtrToProtoString(article.Snippet),
+	}
+}
+
+func ToProtoArticleToc(toc []model.ArticleTocEntry) []*desc.ArticleTocEntry {
+	if toc == nil {
+		return nil
+	}
+
+	res := make([]*desc.ArticleTocEntry, 0, len(toc))
+	for _, entry := range toc {
+		res = append(res, &desc.ArticleTocEntry{
+			Level:  int32(entry.Level),
+			Text:   entry.Text,
+			Anchor: entry.Anchor,
+		})
+	}
+
+	return res
+}
+
+func ProtoToArticleBody(body *desc.ArticleBody) (*model.ArticleBody, error) {
+	imageIds, err := ProtoStringsToMediaIds(body.GetImages())
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ArticleBody{
+		Title:     body.GetTitle(),
+		LatinName: ProtoStringToPtrString(body.GetLatinName()),
+		Text:      ProtoStringToPtrString(body.GetText()),
+		Format:    body.GetFormat(),
+		ImageIds:  imageIds,
+		Status:    body.GetStatus(),
+	}, nil
+}
+
+func ProtoToArticleUpdateInput(req *desc.UpdateArticleRequest) (*model.ArticleUpdateInput, error) {
+	imageIds, err := ProtoStringsToMediaIds(req.GetImages())
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ArticleUpdateInput{
+		Title:     ProtoStringToPtrString(req.GetTitle()),
+		LatinName: ProtoStringToPtrString(req.GetLatinName()),
+		Text:      ProtoStringToPtrString(req.GetText()),
+		Format:    ProtoStringToPtrString(req.GetFormat()),
+		ImageIds:  imageIds,
+		Status:    ProtoStringToPtrString(req.GetStatus()),
+	}, nil
+}
+
+func ProtoInt64ToPtrInt(v *wrapperspb.Int64Value) *int {
+	if v == nil {
+		return nil
+	}
+	val := int(v.GetValue())
+	return &val
+}
+
+// nilIfZero drops an empty image field on create, there is nothing to remove yet.
+func nilIfZero(id *int) *int {
+	if id == nil || *id == 0 {
+		return nil
+	}
+	return id
+}
+
+func ToImageUrls(images []model.ArticleImage) []string {
+	if images == nil {
+		return nil
+	}
+
+	urls := make([]string, 0, len(images))
+	for _, image := range images {
+		urls = append(urls, image.Url)
+	}
+
+	return urls
+}
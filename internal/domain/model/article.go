@@ -2,32 +2,123 @@ package model
 
 import "time"
 
+const (
+	ArticleFormatMarkdown = "markdown"
+	ArticleFormatPlain    = "plain"
+)
+
+const (
+	ArticleSortCreatedAt = "created_at"
+	ArticleSortUpdatedAt = "updated_at"
+	ArticleSortTitle     = "title"
+	ArticleSortRank      = "rank"
+)
+
 type ArticleGetAllParams struct {
 	CropId     *int
 	CategoryId *int
 	Status     *string
+	Query      *string
+
+	// IncludeDescendants widens CategoryId to its subcategories.
+	IncludeDescendants bool
+	// IncludeParentCrops widens CropId to the crops it is a variety of.
+	IncludeParentCrops bool
+
+	Limit  int
+	Cursor *string
+	Sort   string
+	Desc   bool
+}
+
+type ArticleList struct {
+	Articles   []Article
+	NextCursor *string
+	Total      int
 }
 
 type Article struct {
 	Id int `json:"id"`
 	ArticleBody
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	RejectionReason *string   `json:"rejection_reason,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+
+	Relations []ArticleRelation `json:"relations,omitempty"`
+
+	Rank    *float32 `json:"rank,omitempty"`
+	Snippet *string  `json:"snippet,omitempty"`
 }
 
+// ArticleRelation is one of the (crop, category) pairs an article is listed under.
+type ArticleRelation struct {
+	CropId     int `json:"crop_id" validate:"required"`
+	CategoryId int `json:"category_id" validate:"required"`
+}
+
+type InconsistentArticleRelation struct {
+	ArticleId int `json:"article_id"`
+	ArticleRelation
+}
+
+// ArticleBody.Images are the uploaded ImageIds images in order, they are resolved on read and ignored on input.
+// Html and Toc are rendered from Text according to Format when the article is written, they are ignored on input.
+// Format is plain when omitted.
 type ArticleBody struct {
-	Title     string   `json:"title" validate:"required"`
-	LatinName *string  `json:"latin_name,omitempty"`
-	Text      *string  `json:"text,omitempty"`
-	Images    []string `json:"images,omitempty"`
-	Status    string   `json:"status"`
-	Author    *User    `json:"author,omitempty"`
+	Title     string            `json:"title" validate:"required"`
+	LatinName *string           `json:"latin_name,omitempty"`
+	Text      *string           `json:"text,omitempty"`
+	Format    string            `json:"format" validate:"omitempty,oneof=markdown plain"`
+	Html      *string           `json:"html,omitempty"`
+	Toc       []ArticleTocEntry `json:"toc,omitempty"`
+	Images    []ArticleImage    `json:"images,omitempty"`
+	ImageIds  []int             `json:"image_ids,omitempty" validate:"omitempty,dive,gt=0"`
+	Status    string            `json:"status"`
+	Author    *User             `json:"author,omitempty"`
 }
 
+// ArticleUpdateInput.ImageIds replaces the article images when set, an empty list removes them all.
+// Images whose media is kept keep their caption, alt text and attribution.
 type ArticleUpdateInput struct {
-	Title     *string  `json:"title,omitempty"`
-	LatinName *string  `json:"latin_name,omitempty"`
-	Text      *string  `json:"text,omitempty"`
-	Images    []string `json:"images,omitempty"`
-	Status    *string  `json:"status"`
+	Title     *string `json:"title,omitempty"`
+	LatinName *string `json:"latin_name,omitempty"`
+	Text      *string `json:"text,omitempty"`
+	Format    *string `json:"format,omitempty" validate:"omitempty,oneof=markdown plain"`
+	ImageIds  []int   `json:"image_ids,omitempty" validate:"omitempty,dive,gt=0"`
+	Status    *string `json:"status"`
+
+	// ClearLatinName and ClearText set the fields to null, only restoring a revision can do that.
+	ClearLatinName bool `json:"-"`
+	ClearText      bool `json:"-"`
+}
+
+// ArticleTocEntry is a heading of the article text, Anchor is the id of the heading in the html.
+type ArticleTocEntry struct {
+	Level  int    `json:"level"`
+	Text   string `json:"text"`
+	Anchor string `json:"anchor"`
+}
+
+// ArticleImage is one of the images of an article, images are shown by position starting at 0.
+type ArticleImage struct {
+	Id      int  `json:"id"`
+	MediaId *int `json:"media_id,omitempty"`
+	Image
+	Position int `json:"position"`
+	ArticleImageDetails
+}
+
+// ArticleImageDetails fields set to an empty string on update clear the stored value.
+type ArticleImageDetails struct {
+	Caption     *string `json:"caption,omitempty"`
+	Alt         *string `json:"alt,omitempty"`
+	Attribution *string `json:"attribution,omitempty"`
+}
+
+// ArticleImageInput.Position inserts the image before the one at that position,
+// the image is appended when it is omitted or past the last image.
+type ArticleImageInput struct {
+	MediaId  int  `json:"media_id" validate:"required,gt=0"`
+	Position *int `json:"position,omitempty" validate:"omitempty,gte=0"`
+	ArticleImageDetails
 }
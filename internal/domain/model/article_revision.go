@@ -0,0 +1,25 @@
+package model
+
+import (
+	"github.com/nogavadu/articles-service/internal/lib/diff"
+	"time"
+)
+
+type ArticleRevision struct {
+	Id        int       `json:"id"`
+	ArticleId int       `json:"article_id"`
+	Title     string    `json:"title"`
+	LatinName *string   `json:"latin_name,omitempty"`
+	Text      *string   `json:"text,omitempty"`
+	Format    string    `json:"format"`
+	Author    *User     `json:"author,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ArticleRevisionDiff struct {
+	From      int         `json:"from"`
+	To        int         `json:"to"`
+	Title     []diff.Line `json:"title"`
+	LatinName []diff.Line `json:"latin_name"`
+	Text      []diff.Line `json:"text"`
+}
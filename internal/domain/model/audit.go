@@ -0,0 +1,42 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	AuditActionCreate         = "create"
+	AuditActionUpdate         = "update"
+	AuditActionDelete         = "delete"
+	AuditActionAddRelation    = "add_relation"
+	AuditActionRemoveRelation = "remove_relation"
+	AuditActionRestore        = "restore"
+	AuditActionPurge          = "purge"
+)
+
+type AuditEntry struct {
+	Id         int64           `json:"id"`
+	Actor      *int            `json:"actor"`
+	EntityType string          `json:"entity_type"`
+	EntityId   int             `json:"entity_id"`
+	Action     string          `json:"action"`
+	Before     json.RawMessage `json:"before,omitempty"`
+	After      json.RawMessage `json:"after,omitempty"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+type AuditGetAllParams struct {
+	EntityType *string
+	EntityId   *int
+	Actor      *int
+	From       *time.Time
+	To         *time.Time
+	Limit      int
+}
+
+// AuditRelation is the snapshot stored for relation changes between two entities.
+type AuditRelation struct {
+	EntityType string `json:"entity_type"`
+	EntityId   int    `json:"entity_id"`
+}
@@ -0,0 +1,55 @@
+package model
+
+import "time"
+
+const (
+	CalendarActivitySowIndoors = "sow_indoors"
+	CalendarActivitySow        = "sow"
+	CalendarActivityTransplant = "transplant"
+	CalendarActivityPlant      = "plant"
+	CalendarActivityHarvest    = "harvest"
+)
+
+type CalendarEntry struct {
+	Id     int `json:"id"`
+	CropId int `json:"crop_id"`
+	CalendarEntryInfo
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CalendarEntryInfo is the period of an activity in a climate zone, a period
+// ending before it starts wraps around the new year, e.g. November to February.
+// StartWeek and EndWeek optionally narrow it down to ISO weeks and wrap around the same way.
+type CalendarEntryInfo struct {
+	Activity   string  `json:"activity" validate:"required,oneof=sow_indoors sow transplant plant harvest"`
+	Zone       string  `json:"zone" validate:"required,max=16"`
+	StartMonth int     `json:"start_month" validate:"required,min=1,max=12"`
+	EndMonth   int     `json:"end_month" validate:"required,min=1,max=12"`
+	StartWeek  *int    `json:"start_week,omitempty" validate:"required_with=EndWeek,omitempty,min=1,max=53"`
+	EndWeek    *int    `json:"end_week,omitempty" validate:"required_with=StartWeek,omitempty,min=1,max=53"`
+	Notes      *string `json:"notes,omitempty"`
+}
+
+// UpdateCalendarEntryInput.StartWeek and EndWeek set to 0 remove the weeks, an entry has either both or none.
+type UpdateCalendarEntryInput struct {
+	Activity   *string `json:"activity,omitempty" validate:"omitempty,oneof=sow_indoors sow transplant plant harvest"`
+	Zone       *string `json:"zone,omitempty" validate:"omitempty,min=1,max=16"`
+	StartMonth *int    `json:"start_month,omitempty" validate:"omitempty,min=1,max=12"`
+	EndMonth   *int    `json:"end_month,omitempty" validate:"omitempty,min=1,max=12"`
+	StartWeek  *int    `json:"start_week,omitempty" validate:"omitempty,min=0,max=53"`
+	EndWeek    *int    `json:"end_week,omitempty" validate:"omitempty,min=0,max=53"`
+	Notes      *string `json:"notes,omitempty"`
+}
+
+// CalendarZoneParams.Week additionally filters out the entries with weeks that don't cover it.
+type CalendarZoneParams struct {
+	Zone  string
+	Month int
+	Week  *int
+}
+
+type CalendarZoneEntry struct {
+	CalendarEntry
+	CropName string `json:"crop_name"`
+}
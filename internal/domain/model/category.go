@@ -14,21 +14,36 @@ type CategoryGetAllParams struct {
 type Category struct {
 	ID int `json:"id"`
 	CategoryInfo
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	IconVariants    ImageVariants `json:"icon_variants,omitempty"`
+	RejectionReason *string       `json:"rejection_reason,omitempty"`
+	CreatedAt       time.Time     `json:"created_at"`
+	UpdatedAt       time.Time     `json:"updated_at"`
+
+	Children []Category `json:"children,omitempty"`
 }
 
+// CategoryInfo.Icon is the url of the uploaded IconId image, it is resolved on read and ignored on input.
 type CategoryInfo struct {
 	Name        string  `json:"name" validate:"required"`
 	Description *string `json:"description,omitempty"`
 	Icon        *string `json:"icon,omitempty"`
+	IconId      *int    `json:"icon_id,omitempty" validate:"omitempty,gt=0"`
 	Status      string  `json:"status"`
 	Author      *User   `json:"author,omitempty"`
+	ParentId    *int    `json:"parent_id,omitempty"`
+	Position    int     `json:"position" validate:"gte=0"`
 }
 
+// UpdateCategoryInput.IconId replaces the icon, 0 removes it.
 type UpdateCategoryInput struct {
 	Name        *string `json:"name,omitempty"`
 	Description *string `json:"description,omitempty"`
-	Icon        *string `json:"icon,omitempty"`
+	IconId      *int    `json:"icon_id,omitempty" validate:"omitempty,gte=0"`
 	Status      *string `json:"status"`
 }
+
+// CategoryMoveInput places a category under ParentId, or at the top level when it's nil.
+type CategoryMoveInput struct {
+	ParentId *int `json:"parent_id"`
+	Position int  `json:"position" validate:"gte=0"`
+}
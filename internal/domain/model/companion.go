@@ -0,0 +1,37 @@
+package model
+
+import "time"
+
+const (
+	CompanionTypeCompanion  = "companion"
+	CompanionTypeAntagonist = "antagonist"
+	CompanionTypeNeutral    = "neutral"
+)
+
+// CropCompanion is a symmetric relation seen from one of its crops,
+// the same pair is listed under both crops.
+type CropCompanion struct {
+	CompanionId   int    `json:"companion_id"`
+	CompanionName string `json:"companion_name,omitempty"`
+	CropCompanionInfo
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type CropCompanionInfo struct {
+	Type string  `json:"type" validate:"required,oneof=companion antagonist neutral"`
+	Note *string `json:"note,omitempty"`
+}
+
+type BedCheckInput struct {
+	CropIds []int `json:"crop_ids" validate:"required,min=2,max=100,dive,gt=0"`
+}
+
+// BedConflict is a pair of antagonist crops planned in the same bed.
+type BedConflict struct {
+	CropId        int     `json:"crop_id"`
+	CropName      string  `json:"crop_name"`
+	CompanionId   int     `json:"companion_id"`
+	CompanionName string  `json:"companion_name"`
+	Note          *string `json:"note,omitempty"`
+}
@@ -9,21 +9,39 @@ type CropGetAllParams struct {
 type Crop struct {
 	ID int `json:"id"`
 	CropInfo
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ImgVariants     ImageVariants `json:"img_variants,omitempty"`
+	RejectionReason *string       `json:"rejection_reason,omitempty"`
+	CreatedAt       time.Time     `json:"created_at"`
+	UpdatedAt       time.Time     `json:"updated_at"`
 }
 
+// CropInfo.Img is the url of the uploaded ImgId image, it is resolved on read and ignored on input.
 type CropInfo struct {
 	Name        string  `json:"name" validate:"required"`
 	Description *string `json:"description,omitempty"`
 	Img         *string `json:"img,omitempty"`
+	ImgId       *int    `json:"img_id,omitempty" validate:"omitempty,gt=0"`
 	Status      string  `json:"status" validate:"required"`
 	Author      *User   `json:"author,omitempty"`
+
+	// ParentId is set for varieties, it points at the crop they are a cultivar of.
+	ParentId *int    `json:"parent_id,omitempty"`
+	Family   *string `json:"family,omitempty"`
+	Genus    *string `json:"genus,omitempty"`
+	Species  *string `json:"species,omitempty"`
 }
 
+// UpdateCropInput.ImgId replaces the image, 0 removes it.
 type UpdateCropInput struct {
 	Name        *string `json:"name,omitempty"`
 	Description *string `json:"description,omitempty"`
-	Img         *string `json:"img,omitempty"`
+	ImgId       *int    `json:"img_id,omitempty" validate:"omitempty,gte=0"`
 	Status      *string `json:"status,omitempty"`
+	Family      *string `json:"family,omitempty"`
+	Genus       *string `json:"genus,omitempty"`
+	Species     *string `json:"species,omitempty"`
+}
+
+type CropParentInput struct {
+	ParentId *int `json:"parent_id"`
 }
@@ -0,0 +1,46 @@
+package model
+
+import "time"
+
+const (
+	ImageVariantThumb  = "thumb"
+	ImageVariantMedium = "medium"
+	ImageVariantLarge  = "large"
+)
+
+// Media is an uploaded file, image fields of crops, categories, articles and pests reference it by id.
+// Width and Height are unknown for formats the service can't decode, such images have no variants.
+type Media struct {
+	Id          int           `json:"id"`
+	Url         string        `json:"url"`
+	ContentType string        `json:"content_type"`
+	Size        int64         `json:"size"`
+	Checksum    string        `json:"checksum"`
+	Width       *int          `json:"width,omitempty"`
+	Height      *int          `json:"height,omitempty"`
+	Variants    ImageVariants `json:"variants,omitempty"`
+	CreatedAt   time.Time     `json:"created_at"`
+}
+
+// ImageVariants maps a variant name to a downscaled copy of the image, together they make up a srcset.
+// A variant is missing when the original is already smaller than it.
+type ImageVariants map[string]ImageVariant
+
+type ImageVariant struct {
+	Url    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// Image is an uploaded image with its resized variants.
+type Image struct {
+	Url      string        `json:"url"`
+	Variants ImageVariants `json:"variants,omitempty"`
+}
+
+// MediaFile is a stored file, either an uploaded original or one of its variants.
+type MediaFile struct {
+	Key         string
+	ContentType string
+	Size        int64
+}
@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+const (
+	EntityCrop     = "crop"
+	EntityCategory = "category"
+	EntityArticle  = "article"
+	EntityPest     = "pest"
+
+	// EntityCalendarEntry only shows up in the audit log.
+	EntityCalendarEntry = "calendar_entry"
+)
+
+type ModerationItem struct {
+	EntityType  string    `json:"entity_type"`
+	EntityId    int       `json:"entity_id"`
+	Title       string    `json:"title"`
+	Author      *User     `json:"author,omitempty"`
+	SubmittedAt time.Time `json:"submitted_at"`
+	AgeSeconds  int64     `json:"age_seconds"`
+}
+
+type ModerationRejectInput struct {
+	Reason string `json:"reason" validate:"required"`
+}
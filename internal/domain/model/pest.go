@@ -0,0 +1,61 @@
+package model
+
+import "time"
+
+const (
+	PestTypePest    = "pest"
+	PestTypeDisease = "disease"
+)
+
+type PestGetAllParams struct {
+	Status *string
+	// CropId lists only the pests threatening the crop.
+	CropId *int
+}
+
+type Pest struct {
+	ID int `json:"id"`
+	PestInfo
+	RejectionReason *string   `json:"rejection_reason,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+
+	// Crops and Articles are only filled for a single pest.
+	Crops    []PestCrop    `json:"crops,omitempty"`
+	Articles []PestArticle `json:"articles,omitempty"`
+}
+
+// PestInfo.Images are the urls of the uploaded ImageIds images, they are resolved on read and ignored on input.
+type PestInfo struct {
+	Name        string   `json:"name" validate:"required"`
+	LatinName   *string  `json:"latin_name,omitempty"`
+	Type        string   `json:"type" validate:"required,oneof=pest disease"`
+	Symptoms    *string  `json:"symptoms,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Images      []string `json:"images,omitempty"`
+	ImageIds    []int    `json:"image_ids,omitempty" validate:"omitempty,dive,gt=0"`
+	Status      string   `json:"status" validate:"required"`
+	Author      *User    `json:"author,omitempty"`
+}
+
+type UpdatePestInput struct {
+	Name        *string `json:"name,omitempty" validate:"omitempty,min=1"`
+	LatinName   *string `json:"latin_name,omitempty"`
+	Type        *string `json:"type,omitempty" validate:"omitempty,oneof=pest disease"`
+	Symptoms    *string `json:"symptoms,omitempty"`
+	Description *string `json:"description,omitempty"`
+	ImageIds    []int   `json:"image_ids,omitempty" validate:"omitempty,dive,gt=0"`
+	Status      *string `json:"status,omitempty"`
+}
+
+// PestCrop is a crop the pest affects.
+type PestCrop struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// PestArticle is an article on preventing or treating the pest.
+type PestArticle struct {
+	Id    int    `json:"id"`
+	Title string `json:"title"`
+}
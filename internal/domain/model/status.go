@@ -1,5 +1,11 @@
 package model
 
+const (
+	StatusPublished = "published"
+	StatusReview    = "review"
+	StatusCanceled  = "canceled"
+)
+
 type Status struct {
 	Id     int    `json:"id"`
 	Status string `json:"status"`
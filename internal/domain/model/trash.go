@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+type TrashItem struct {
+	EntityType string    `json:"entity_type"`
+	EntityId   int       `json:"entity_id"`
+	Title      string    `json:"title"`
+	Author     *User     `json:"author,omitempty"`
+	DeletedAt  time.Time `json:"deleted_at"`
+	PurgeAt    time.Time `json:"purge_at"`
+}
@@ -0,0 +1,53 @@
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+var ErrUndocumentedRoutes = errors.New("routes missing from the openapi document")
+
+func Handler(doc *Document) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		render.JSON(w, r, doc)
+	}
+}
+
+// Verify checks that every route registered in routes is documented and every documented operation is routed.
+func Verify(routes chi.Routes, doc *Document) error {
+	routed := make(map[string]struct{})
+	var problems []string
+
+	err := chi.Walk(routes, func(method string, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		path := normalizePath(route)
+		routed[method+" "+path] = struct{}{}
+
+		if _, ok := doc.Paths[path][strings.ToLower(method)]; !ok {
+			problems = append(problems, fmt.Sprintf("%s %s is not documented", method, path))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for path, ops := range doc.Paths {
+		for method := range ops {
+			if _, ok := routed[strings.ToUpper(method)+" "+path]; !ok {
+				problems = append(problems, fmt.Sprintf("%s %s is documented but not routed", strings.ToUpper(method), path))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("%w: %s", ErrUndocumentedRoutes, strings.Join(problems, "; "))
+	}
+
+	return nil
+}
@@ -0,0 +1,252 @@
+package openapi
+
+import (
+	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	version = "3.0.3"
+
+	bearerAuth  = "bearerAuth"
+	errorSchema = "Error"
+)
+
+var pathParamRe = regexp.MustCompile(`\{(\w+)\}`)
+
+// Param is a query parameter of an operation.
+// Path parameters are taken from the route pattern and don't need to be listed.
+type Param struct {
+	Name        string
+	Type        string
+	Format      string
+	Description string
+	Required    bool
+}
+
+// Operation describes a single route.
+// Request and Response are sample values of the handler's body types, their schemas are built by reflection.
+type Operation struct {
+	Method   string
+	Path     string
+	Tag      string
+	Summary  string
+	Auth     bool
+	Query    []Param
+	Request  any
+	Response any
+	// Upload is the multipart form field of an uploaded file, the operation has no Request then.
+	Upload string
+	// Binary marks a response that streams a file instead of JSON.
+	Binary bool
+	// Status is the success status code, http.StatusOK when zero.
+	Status int
+	// Errors lists the error statuses answered with response.Response besides 500.
+	Errors []int
+}
+
+// Mount prefixes the paths of ops with the route they are mounted at and tags them.
+func Mount(prefix string, tag string, ops []Operation) []Operation {
+	mounted := make([]Operation, 0, len(ops))
+	for _, op := range ops {
+		op.Path = normalizePath(prefix + op.Path)
+		op.Tag = tag
+		mounted = append(mounted, op)
+	}
+	return mounted
+}
+
+type Document struct {
+	OpenAPI    string                       `json:"openapi"`
+	Info       Info                         `json:"info"`
+	Paths      map[string]map[string]*docOp `json:"paths"`
+	Components components                   `json:"components"`
+}
+
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type components struct {
+	Schemas         map[string]*Schema        `json:"schemas"`
+	SecuritySchemes map[string]securityScheme `json:"securitySchemes"`
+}
+
+type securityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+}
+
+type docOp struct {
+	Tags        []string                `json:"tags,omitempty"`
+	Summary     string                  `json:"summary,omitempty"`
+	OperationId string                  `json:"operationId"`
+	Parameters  []docParam              `json:"parameters,omitempty"`
+	RequestBody *docBody                `json:"requestBody,omitempty"`
+	Responses   map[string]*docResponse `json:"responses"`
+	Security    []map[string][]string   `json:"security,omitempty"`
+}
+
+type docParam struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required"`
+	Schema      *Schema `json:"schema"`
+}
+
+type docBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]mediaType `json:"content"`
+}
+
+type docResponse struct {
+	Description string               `json:"description"`
+	Content     map[string]mediaType `json:"content,omitempty"`
+}
+
+type mediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// New builds the document from the operations of every registered route.
+func New(info Info, ops []Operation) *Document {
+	doc := &Document{
+		OpenAPI: version,
+		Info:    info,
+		Paths:   make(map[string]map[string]*docOp),
+		Components: components{
+			Schemas: make(map[string]*Schema),
+			SecuritySchemes: map[string]securityScheme{
+				bearerAuth: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			},
+		},
+	}
+
+	schemas := newSchemas(doc.Components.Schemas)
+	doc.Components.Schemas[errorSchema] = schemas.of(response.Response{})
+
+	for _, op := range ops {
+		path := normalizePath(op.Path)
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = make(map[string]*docOp)
+		}
+		doc.Paths[path][strings.ToLower(op.Method)] = buildOp(schemas, path, op)
+	}
+
+	return doc
+}
+
+func buildOp(schemas *schemas, path string, op Operation) *docOp {
+	res := &docOp{
+		Summary:     op.Summary,
+		OperationId: operationId(op.Method, path),
+		Responses:   make(map[string]*docResponse),
+	}
+	if op.Tag != "" {
+		res.Tags = []string{op.Tag}
+	}
+
+	for _, m := range pathParamRe.FindAllStringSubmatch(path, -1) {
+		schema := &Schema{Type: "string"}
+		if strings.HasSuffix(m[1], "Id") {
+			schema = &Schema{Type: "integer"}
+		}
+		res.Parameters = append(res.Parameters, docParam{Name: m[1], In: "path", Required: true, Schema: schema})
+	}
+	for _, p := range op.Query {
+		typ := p.Type
+		if typ == "" {
+			typ = "string"
+		}
+		res.Parameters = append(res.Parameters, docParam{
+			Name:        p.Name,
+			In:          "query",
+			Description: p.Description,
+			Required:    p.Required,
+			Schema:      &Schema{Type: typ, Format: p.Format},
+		})
+	}
+
+	if op.Request != nil {
+		res.RequestBody = &docBody{
+			Required: true,
+			Content:  map[string]mediaType{"application/json": {Schema: schemas.of(op.Request)}},
+		}
+	}
+
+	if op.Upload != "" {
+		res.RequestBody = &docBody{
+			Required: true,
+			Content: map[string]mediaType{"multipart/form-data": {Schema: &Schema{
+				Type:       "object",
+				Properties: map[string]*Schema{op.Upload: {Type: "string", Format: "binary"}},
+				Required:   []string{op.Upload},
+			}}},
+		}
+	}
+
+	status := op.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	success := &docResponse{Description: http.StatusText(status)}
+	if op.Response != nil {
+		success.Content = map[string]mediaType{"application/json": {Schema: schemas.of(op.Response)}}
+	}
+	if op.Binary {
+		success.Content = map[string]mediaType{"application/octet-stream": {Schema: &Schema{Type: "string", Format: "binary"}}}
+	}
+	res.Responses[statusKey(status)] = success
+
+	errs := append([]int{http.StatusInternalServerError}, op.Errors...)
+	if op.Auth {
+		errs = append(errs, http.StatusUnauthorized)
+		res.Security = []map[string][]string{{bearerAuth: {}}}
+	}
+	for _, code := range errs {
+		res.Responses[statusKey(code)] = &docResponse{
+			Description: http.StatusText(code),
+			Content: map[string]mediaType{
+				"application/json": {Schema: &Schema{Ref: componentRef(errorSchema)}},
+			},
+		}
+	}
+
+	return res
+}
+
+// operationId turns "GET /api/crops/{cropId}" into "getApiCropsByCropId".
+func operationId(method string, path string) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(method))
+	for _, part := range strings.Split(path, "/") {
+		if part == "" {
+			continue
+		}
+		if strings.HasPrefix(part, "{") {
+			b.WriteString("By")
+			part = strings.Trim(part, "{}")
+		}
+		for _, word := range strings.FieldsFunc(part, func(r rune) bool { return r == '_' || r == '-' || r == '.' }) {
+			b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	return b.String()
+}
+
+func statusKey(code int) string {
+	return strconv.Itoa(code)
+}
+
+func normalizePath(path string) string {
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+	return path
+}
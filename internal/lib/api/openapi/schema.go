@@ -0,0 +1,143 @@
+package openapi
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+}
+
+// schemas describes Go types, a struct that contains itself can't be inlined
+// so it is added to components once and referenced everywhere.
+type schemas struct {
+	components map[string]*Schema
+	building   map[reflect.Type]bool
+	recursive  map[reflect.Type]bool
+}
+
+func newSchemas(components map[string]*Schema) *schemas {
+	return &schemas{
+		components: components,
+		building:   make(map[reflect.Type]bool),
+		recursive:  make(map[reflect.Type]bool),
+	}
+}
+
+// of describes the JSON encoding of v following its json and validate tags.
+func (s *schemas) of(v any) *Schema {
+	return s.schemaOf(reflect.TypeOf(v))
+}
+
+func (s *schemas) schemaOf(t reflect.Type) *Schema {
+	if t == nil {
+		return &Schema{}
+	}
+
+	if t.Kind() == reflect.Pointer {
+		res := s.schemaOf(t.Elem())
+		res.Nullable = true
+		return res
+	}
+
+	if t == timeType {
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: s.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: s.schemaOf(t.Elem())}
+	case reflect.Struct:
+		if s.building[t] || s.recursive[t] {
+			s.recursive[t] = true
+			return &Schema{Ref: componentRef(t.Name())}
+		}
+
+		s.building[t] = true
+		res := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+		s.addFields(res, t)
+		delete(s.building, t)
+
+		if s.recursive[t] {
+			s.components[t.Name()] = res
+			return &Schema{Ref: componentRef(t.Name())}
+		}
+		return res
+	default:
+		return &Schema{}
+	}
+}
+
+// addFields adds the fields of t to s, inlining embedded structs the way encoding/json does.
+func (s *schemas) addFields(res *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				s.addFields(res, ft)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		fs := s.schemaOf(f.Type)
+		for _, rule := range strings.Split(f.Tag.Get("validate"), ",") {
+			switch rule {
+			case "required":
+				res.Required = append(res.Required, name)
+			case "email":
+				fs.Format = "email"
+			case "url":
+				fs.Format = "uri"
+			}
+		}
+		res.Properties[name] = fs
+	}
+}
+
+func componentRef(name string) string {
+	return "#/components/schemas/" + name
+}
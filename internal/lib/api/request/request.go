@@ -2,6 +2,7 @@ package request
 
 import (
 	"fmt"
+	"github.com/nogavadu/articles-service/internal/lib/identity"
 	"net/http"
 	"reflect"
 	"strings"
@@ -47,3 +48,18 @@ func GetAuthToken(r *http.Request) (string, error) {
 	token := tokenParts[1]
 	return token, nil
 }
+
+// CheckUserId rejects a client supplied user id that differs from the authenticated caller.
+// The field is optional and only kept for older clients, the author always comes from the token.
+func CheckUserId(r *http.Request, userId *int) error {
+	if userId == nil {
+		return nil
+	}
+
+	callerId, ok := identity.UserId(r.Context())
+	if !ok || callerId != *userId {
+		return fmt.Errorf("user_id does not match the authenticated user")
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package request
+
+import (
+	"github.com/go-playground/validator/v10"
+	"reflect"
+	"strings"
+)
+
+var validate = newValidator()
+
+// newValidator reports fields by their json names so validation errors match the request body.
+func newValidator() *validator.Validate {
+	v := validator.New()
+	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		name, _, _ := strings.Cut(fld.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+	return v
+}
+
+// Validate checks the validate tags of a decoded request body.
+func Validate(v any) error {
+	return validate.Struct(v)
+}
@@ -1,15 +1,199 @@
 package response
 
 import (
+	"errors"
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+	"github.com/nogavadu/articles-service/internal/lib/apperr"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 	"net/http"
+	"strings"
+	"unicode"
+)
+
+const (
+	CodeInvalidArgument  = "invalid_argument"
+	CodeUnauthenticated  = "unauthenticated"
+	CodeAccessDenied     = "access_denied"
+	CodeNotFound         = "not_found"
+	CodeAlreadyExists    = "already_exists"
+	CodeConflict         = "conflict"
+	CodeTooLarge         = "too_large"
+	CodeInternal         = "internal"
+	internalErrorMessage = "internal server error"
 )
 
 type Response struct {
-	Error string `json:"error,omitempty"`
+	Error *ErrorBody `json:"error"`
+}
+
+type ErrorBody struct {
+	Code      string       `json:"code"`
+	Message   string       `json:"message"`
+	Details   []FieldError `json:"details,omitempty"`
+	RequestId string       `json:"request_id,omitempty"`
 }
 
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Err responds with an error the handler detected itself, e.g. a malformed path param.
 func Err(w http.ResponseWriter, r *http.Request, errMsg string, status int) {
+	write(w, r, status, &ErrorBody{
+		Code:    statusCode(status),
+		Message: errMsg,
+	})
+}
+
+// Error maps err to the response status.
+// Service errors are matched by kind, validation errors get a detail per field,
+// anything else is reported as an internal error without leaking its message.
+func Error(w http.ResponseWriter, r *http.Request, err error) {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		write(w, r, http.StatusBadRequest, &ErrorBody{
+			Code:    CodeInvalidArgument,
+			Message: "invalid arguments",
+			Details: fieldErrors(validationErrs),
+		})
+		return
+	}
+
+	status := errorStatus(err)
+	msg := internalErrorMessage
+
+	var serviceErr *apperr.Error
+	if errors.As(err, &serviceErr) {
+		msg = serviceErr.Error()
+	} else if st, ok := remoteStatus(err); ok && status != http.StatusInternalServerError {
+		// The auth service reports user facing errors, e.g. a wrong password, as gRPC statuses.
+		msg = st.Message()
+	}
+
+	write(w, r, status, &ErrorBody{
+		Code:    errorCode(err, status),
+		Message: msg,
+	})
+}
+
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, apperr.ErrInvalidArguments):
+		return http.StatusBadRequest
+	case errors.Is(err, apperr.ErrAccessDenied):
+		return http.StatusForbidden
+	case errors.Is(err, apperr.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, apperr.ErrAlreadyExists), errors.Is(err, apperr.ErrIllegalTransition), errors.Is(err, apperr.ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, apperr.ErrTooLarge):
+		return http.StatusRequestEntityTooLarge
+	}
+
+	if st, ok := remoteStatus(err); ok {
+		switch st.Code() {
+		case codes.InvalidArgument:
+			return http.StatusBadRequest
+		case codes.Unauthenticated:
+			return http.StatusUnauthorized
+		case codes.PermissionDenied:
+			return http.StatusForbidden
+		case codes.NotFound:
+			return http.StatusNotFound
+		case codes.AlreadyExists:
+			return http.StatusConflict
+		}
+	}
+
+	return http.StatusInternalServerError
+}
+
+func errorCode(err error, status int) string {
+	if st, ok := remoteStatus(err); errors.Is(err, apperr.ErrAlreadyExists) || ok && st.Code() == codes.AlreadyExists {
+		return CodeAlreadyExists
+	}
+	return statusCode(status)
+}
+
+func statusCode(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return CodeInvalidArgument
+	case http.StatusUnauthorized:
+		return CodeUnauthenticated
+	case http.StatusForbidden:
+		return CodeAccessDenied
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusRequestEntityTooLarge:
+		return CodeTooLarge
+	default:
+		return CodeInternal
+	}
+}
+
+func write(w http.ResponseWriter, r *http.Request, status int, body *ErrorBody) {
+	body.RequestId = middleware.GetReqID(r.Context())
+
 	render.Status(r, status)
-	render.JSON(w, r, &Response{Error: errMsg})
+	render.JSON(w, r, &Response{Error: body})
+}
+
+func fieldErrors(errs validator.ValidationErrors) []FieldError {
+	details := make([]FieldError, 0, len(errs))
+	for _, e := range errs {
+		details = append(details, FieldError{
+			Field:   fieldName(e.Namespace()),
+			Message: fieldMessage(e),
+		})
+	}
+	return details
+}
+
+// fieldName turns "createRequest.crop.name" into "crop.name".
+// Embedded structs keep their Go name in the namespace and are dropped, json names are lowercase.
+func fieldName(namespace string) string {
+	parts := strings.Split(namespace, ".")
+	fields := make([]string, 0, len(parts))
+	for _, p := range parts[1:] {
+		if p != "" && unicode.IsUpper(rune(p[0])) {
+			continue
+		}
+		fields = append(fields, p)
+	}
+	return strings.Join(fields, ".")
+}
+
+func fieldMessage(e validator.FieldError) string {
+	switch e.Tag() {
+	case "required":
+		return "is required"
+	case "email":
+		return "must be a valid email"
+	case "url":
+		return "must be a valid url"
+	case "min":
+		return "must be at least " + e.Param()
+	case "max":
+		return "must be at most " + e.Param()
+	case "oneof":
+		return "must be one of " + e.Param()
+	default:
+		return "failed " + e.Tag() + " validation"
+	}
+}
+
+// remoteStatus extracts the gRPC status the auth service answered with, the message is the remote one without local wrapping.
+func remoteStatus(err error) (*grpcStatus.Status, bool) {
+	var withStatus interface{ GRPCStatus() *grpcStatus.Status }
+	if !errors.As(err, &withStatus) || withStatus.GRPCStatus() == nil {
+		return nil, false
+	}
+	return withStatus.GRPCStatus(), true
 }
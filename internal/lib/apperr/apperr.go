@@ -0,0 +1,33 @@
+package apperr
+
+import "errors"
+
+// Error kinds shared by the services.
+// Every service defines its own sentinel errors with New so callers can map them without knowing the service.
+var (
+	ErrNotFound          = errors.New("not found")
+	ErrAlreadyExists     = errors.New("already exists")
+	ErrInvalidArguments  = errors.New("invalid arguments")
+	ErrAccessDenied      = errors.New("access denied")
+	ErrIllegalTransition = errors.New("illegal transition")
+	ErrConflict          = errors.New("conflict")
+	ErrTooLarge          = errors.New("too large")
+)
+
+type Error struct {
+	kind error
+	msg  string
+}
+
+// New returns a sentinel error with its own message that errors.Is matches against kind.
+func New(kind error, msg string) error {
+	return &Error{kind: kind, msg: msg}
+}
+
+func (e *Error) Error() string {
+	return e.msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.kind
+}
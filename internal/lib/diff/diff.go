@@ -0,0 +1,117 @@
+package diff
+
+import "strings"
+
+const (
+	OpEqual  = "equal"
+	OpInsert = "insert"
+	OpDelete = "delete"
+)
+
+type Line struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+// Lines returns a line-level diff turning a into b, based on the longest common subsequence of lines.
+func Lines(a, b string) []Line {
+	aLines, bLines := splitLines(a), splitLines(b)
+
+	// Common prefix and suffix do not take part in the LCS search.
+	prefix := 0
+	for prefix < len(aLines) && prefix < len(bLines) && aLines[prefix] == bLines[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(aLines)-prefix && suffix < len(bLines)-prefix &&
+		aLines[len(aLines)-1-suffix] == bLines[len(bLines)-1-suffix] {
+		suffix++
+	}
+
+	result := make([]Line, 0, len(aLines)+len(bLines))
+	for _, l := range aLines[:prefix] {
+		result = append(result, Line{Op: OpEqual, Text: l})
+	}
+
+	result = lcsDiff(aLines[prefix:len(aLines)-suffix], bLines[prefix:len(bLines)-suffix], result)
+
+	for _, l := range aLines[len(aLines)-suffix:] {
+		result = append(result, Line{Op: OpEqual, Text: l})
+	}
+
+	return result
+}
+
+// lcsDiff finds the longest common subsequence with Hirschberg's algorithm, which keeps only two rows of the
+// LCS table at a time, so memory stays linear however long the revisions are.
+func lcsDiff(a, b []string, result []Line) []Line {
+	switch {
+	case len(a) == 0:
+		for _, l := range b {
+			result = append(result, Line{Op: OpInsert, Text: l})
+		}
+		return result
+	case len(b) == 0:
+		for _, l := range a {
+			result = append(result, Line{Op: OpDelete, Text: l})
+		}
+		return result
+	case len(a) == 1:
+		for j, l := range b {
+			if l == a[0] {
+				result = lcsDiff(nil, b[:j], result)
+				result = append(result, Line{Op: OpEqual, Text: l})
+				return lcsDiff(nil, b[j+1:], result)
+			}
+		}
+		result = append(result, Line{Op: OpDelete, Text: a[0]})
+		return lcsDiff(nil, b, result)
+	}
+
+	// Split a in half and b where the LCS of both halves adds up to the longest.
+	mid := len(a) / 2
+	forward := lcsLengths(a[:mid], b, false)
+	backward := lcsLengths(a[mid:], b, true)
+
+	split, best := 0, -1
+	for j := range forward {
+		if l := forward[j] + backward[len(b)-j]; l > best {
+			split, best = j, l
+		}
+	}
+
+	result = lcsDiff(a[:mid], b[:split], result)
+	return lcsDiff(a[mid:], b[split:], result)
+}
+
+// lcsLengths returns lengths where lengths[j] is the LCS length of a and the first j lines of b,
+// or of a and the last j lines of b when reverse is set.
+func lcsLengths(a, b []string, reverse bool) []int {
+	prev, cur := make([]int, len(b)+1), make([]int, len(b)+1)
+	for i := range a {
+		ai := a[i]
+		if reverse {
+			ai = a[len(a)-1-i]
+		}
+		for j := 1; j <= len(b); j++ {
+			bj := b[j-1]
+			if reverse {
+				bj = b[len(b)-j]
+			}
+			if ai == bj {
+				cur[j] = prev[j-1] + 1
+			} else {
+				cur[j] = max(prev[j], cur[j-1])
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+}
@@ -0,0 +1,135 @@
+package diff
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want []Line
+	}{
+		{name: "both empty", a: "", b: "", want: []Line{}},
+		{
+			name: "from empty",
+			a:    "",
+			b:    "x\ny",
+			want: []Line{{OpInsert, "x"}, {OpInsert, "y"}},
+		},
+		{
+			name: "to empty",
+			a:    "x\ny",
+			b:    "",
+			want: []Line{{OpDelete, "x"}, {OpDelete, "y"}},
+		},
+		{
+			name: "equal",
+			a:    "x\ny",
+			b:    "x\ny",
+			want: []Line{{OpEqual, "x"}, {OpEqual, "y"}},
+		},
+		{
+			name: "crlf is the same line break",
+			a:    "x\r\ny",
+			b:    "x\ny",
+			want: []Line{{OpEqual, "x"}, {OpEqual, "y"}},
+		},
+		{
+			name: "common prefix and suffix are kept",
+			a:    "head\nold\ntail",
+			b:    "head\nnew\ntail",
+			want: []Line{{OpEqual, "head"}, {OpDelete, "old"}, {OpInsert, "new"}, {OpEqual, "tail"}},
+		},
+		{
+			name: "replaced lines delete before insert",
+			a:    "a\nb",
+			b:    "c\nd",
+			want: []Line{{OpDelete, "a"}, {OpDelete, "b"}, {OpInsert, "c"}, {OpInsert, "d"}},
+		},
+		{
+			name: "insert in the middle",
+			a:    "a\nc",
+			b:    "a\nb\nc",
+			want: []Line{{OpEqual, "a"}, {OpInsert, "b"}, {OpEqual, "c"}},
+		},
+		{
+			name: "delete in the middle",
+			a:    "a\nb\nc",
+			b:    "a\nc",
+			want: []Line{{OpEqual, "a"}, {OpDelete, "b"}, {OpEqual, "c"}},
+		},
+		{
+			name: "moved line",
+			a:    "a\nb\nc",
+			b:    "b\nc\na",
+			want: []Line{{OpDelete, "a"}, {OpEqual, "b"}, {OpEqual, "c"}, {OpInsert, "a"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Lines(tt.a, tt.b); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Lines() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestLinesRandom checks the diff rebuilds both texts and keeps as many lines as the longest common subsequence.
+func TestLinesRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	text := func() string {
+		lines := make([]string, rnd.Intn(30))
+		for i := range lines {
+			lines[i] = fmt.Sprint(rnd.Intn(5))
+		}
+		return strings.Join(lines, "\n")
+	}
+
+	for i := 0; i < 500; i++ {
+		a, b := text(), text()
+		got := Lines(a, b)
+
+		var oldLines, newLines []string
+		equal := 0
+		for _, l := range got {
+			if l.Op != OpInsert {
+				oldLines = append(oldLines, l.Text)
+			}
+			if l.Op != OpDelete {
+				newLines = append(newLines, l.Text)
+			}
+			if l.Op == OpEqual {
+				equal++
+			}
+		}
+		if !reflect.DeepEqual(oldLines, splitLines(a)) || !reflect.DeepEqual(newLines, splitLines(b)) {
+			t.Fatalf("Lines(%q, %q) = %v does not rebuild the texts", a, b, got)
+		}
+		if want := lcsLength(splitLines(a), splitLines(b)); equal != want {
+			t.Fatalf("Lines(%q, %q) keeps %d lines, want %d", a, b, equal, want)
+		}
+	}
+}
+
+func lcsLength(a, b []string) int {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := range a {
+		for j := range b {
+			if a[i] == b[j] {
+				lcs[i+1][j+1] = lcs[i][j] + 1
+			} else {
+				lcs[i+1][j+1] = max(lcs[i][j+1], lcs[i+1][j])
+			}
+		}
+	}
+	return lcs[len(a)][len(b)]
+}
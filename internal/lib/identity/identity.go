@@ -0,0 +1,16 @@
+package identity
+
+import "context"
+
+type userIdKey struct{}
+
+// WithUserId stores the id of the authenticated caller, set once by the auth middleware.
+func WithUserId(ctx context.Context, userId int) context.Context {
+	return context.WithValue(ctx, userIdKey{}, userId)
+}
+
+// UserId returns the id of the authenticated caller, ok is false for anonymous requests.
+func UserId(ctx context.Context) (int, bool) {
+	userId, ok := ctx.Value(userIdKey{}).(int)
+	return userId, ok
+}
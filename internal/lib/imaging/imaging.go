@@ -0,0 +1,149 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	_ "golang.org/x/image/webp"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+)
+
+const (
+	FormatJPEG = "jpeg"
+	FormatPNG  = "png"
+	FormatGIF  = "gif"
+	FormatWebP = "webp"
+
+	// maxPixels guards against decompression bombs, a small file may declare a huge canvas.
+	maxPixels = 50_000_000
+
+	jpegQuality = 85
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	ErrTooManyPixels     = errors.New("image dimensions are too large")
+)
+
+// Decode reads a jpeg, png, gif or webp image and returns its format, the dimensions are checked before decoding.
+func Decode(data []byte) (image.Image, string, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", errors.Join(ErrUnsupportedFormat, err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxPixels {
+		return nil, "", ErrTooManyPixels
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return img, format, nil
+}
+
+// Fit scales img down so that neither side exceeds maxSide, keeping the aspect ratio.
+// ok is false when img already fits, images are never scaled up.
+func Fit(img *image.RGBA, maxSide int) (*image.RGBA, bool) {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= maxSide && h <= maxSide {
+		return nil, false
+	}
+
+	dw, dh := maxSide, maxSide
+	if w >= h {
+		dh = max(1, h*maxSide/w)
+	} else {
+		dw = max(1, w*maxSide/h)
+	}
+
+	return resize(img, dw, dh), true
+}
+
+// Encode writes img as jpeg or png, gif sources are encoded as png since a single frame is kept.
+// There is no webp encoder, webp sources become jpeg or png when they have transparency.
+// The returned format is the one actually written.
+func Encode(w io.Writer, img *image.RGBA, format string) (string, error) {
+	if format == FormatWebP {
+		format = FormatJPEG
+		if !img.Opaque() {
+			format = FormatPNG
+		}
+	}
+
+	switch format {
+	case FormatJPEG:
+		return FormatJPEG, jpeg.Encode(w, img, &jpeg.Options{Quality: jpegQuality})
+	case FormatPNG, FormatGIF:
+		return FormatPNG, png.Encode(w, img)
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// ToRGBA converts img once so every variant can be resized from the same pixels.
+func ToRGBA(img image.Image) *image.RGBA {
+	if rgba, ok := img.(*image.RGBA); ok {
+		return rgba
+	}
+
+	b := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), img, b.Min, draw.Src)
+	return rgba
+}
+
+// resize downscales with a box filter, every destination pixel is the average of the source pixels it covers.
+// RGBA is alpha premultiplied so transparent edges don't darken.
+func resize(src *image.RGBA, dw, dh int) *image.RGBA {
+	sb := src.Bounds()
+	sw, sh := sb.Dx(), sb.Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+	xs := spans(sw, dw)
+	ys := spans(sh, dh)
+
+	for dy := 0; dy < dh; dy++ {
+		y0, y1 := ys[dy], ys[dy+1]
+		for dx := 0; dx < dw; dx++ {
+			x0, x1 := xs[dx], xs[dx+1]
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				off := src.PixOffset(sb.Min.X+x0, sb.Min.Y+sy)
+				for sx := x0; sx < x1; sx++ {
+					r += uint64(src.Pix[off])
+					g += uint64(src.Pix[off+1])
+					b += uint64(src.Pix[off+2])
+					a += uint64(src.Pix[off+3])
+					off += 4
+				}
+				n += uint64(x1 - x0)
+			}
+
+			off := dst.PixOffset(dx, dy)
+			dst.Pix[off] = uint8(r / n)
+			dst.Pix[off+1] = uint8(g / n)
+			dst.Pix[off+2] = uint8(b / n)
+			dst.Pix[off+3] = uint8(a / n)
+		}
+	}
+
+	return dst
+}
+
+// spans splits n source pixels into d consecutive non-empty ranges, range i is [s[i], s[i+1]).
+func spans(n, d int) []int {
+	s := make([]int, d+1)
+	for i := 1; i <= d; i++ {
+		s[i] = max(i*n/d, s[i-1]+1)
+	}
+	s[d] = n
+	return s
+}
@@ -0,0 +1,158 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+)
+
+func filled(w, h int, c color.RGBA) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3] = c.R, c.G, c.B, c.A
+	}
+	return img
+}
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		name          string
+		w, h, maxSide int
+		wantW, wantH  int
+		ok            bool
+	}{
+		{name: "already fits", w: 100, h: 50, maxSide: 100, ok: false},
+		{name: "landscape", w: 400, h: 200, maxSide: 100, wantW: 100, wantH: 50, ok: true},
+		{name: "portrait", w: 300, h: 900, maxSide: 300, wantW: 100, wantH: 300, ok: true},
+		{name: "square", w: 500, h: 500, maxSide: 64, wantW: 64, wantH: 64, ok: true},
+		{name: "thin strip keeps a pixel", w: 1000, h: 1, maxSide: 10, wantW: 10, wantH: 1, ok: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Fit(filled(tt.w, tt.h, color.RGBA{A: 255}), tt.maxSide)
+			if ok != tt.ok {
+				t.Fatalf("Fit() ok = %t, want %t", ok, tt.ok)
+			}
+			if !ok {
+				return
+			}
+			if w, h := got.Bounds().Dx(), got.Bounds().Dy(); w != tt.wantW || h != tt.wantH {
+				t.Errorf("Fit() = %dx%d, want %dx%d", w, h, tt.wantW, tt.wantH)
+			}
+		})
+	}
+}
+
+func TestFitAveragesPixels(t *testing.T) {
+	// Left half black, right half white, scaled to two pixels wide.
+	src := filled(4, 2, color.RGBA{R: 255, G: 255, B: 255, A: 255})
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 2; x++ {
+			src.SetRGBA(x, y, color.RGBA{A: 255})
+		}
+	}
+
+	got, _ := Fit(src, 2)
+	if c := got.RGBAAt(0, 0); c != (color.RGBA{A: 255}) {
+		t.Errorf("left pixel = %v, want black", c)
+	}
+	if c := got.RGBAAt(1, 0); c != (color.RGBA{R: 255, G: 255, B: 255, A: 255}) {
+		t.Errorf("right pixel = %v, want white", c)
+	}
+}
+
+func TestSpans(t *testing.T) {
+	for n := 1; n <= 50; n++ {
+		for d := 1; d <= n; d++ {
+			s := spans(n, d)
+			if s[0] != 0 || s[d] != n {
+				t.Fatalf("spans(%d, %d) = %v, want 0..%d", n, d, s, n)
+			}
+			for i := 0; i < d; i++ {
+				if s[i+1] <= s[i] {
+					t.Fatalf("spans(%d, %d) = %v, range %d is empty", n, d, s, i)
+				}
+			}
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, filled(3, 2, color.RGBA{A: 255})); err != nil {
+		t.Fatal(err)
+	}
+
+	img, format, err := Decode(buf.Bytes())
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if format != FormatPNG || img.Bounds().Dx() != 3 || img.Bounds().Dy() != 2 {
+		t.Errorf("Decode() = %s %v, want png 3x2", format, img.Bounds())
+	}
+
+	if _, _, err := Decode([]byte("not an image")); !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("Decode(garbage) error = %v, want %v", err, ErrUnsupportedFormat)
+	}
+}
+
+func TestDecodeTooManyPixels(t *testing.T) {
+	// Only the header is read before rejecting, so a tiny file declaring a huge canvas is enough.
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, filled(1, 1, color.RGBA{A: 255})); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	// The IHDR width and height follow the 8 byte signature, the chunk length and type, its crc follows the 13 byte data.
+	copy(data[16:24], []byte{0, 0, 0x27, 0x10, 0, 0, 0x27, 0x10})
+	binary.BigEndian.PutUint32(data[29:33], crc32.ChecksumIEEE(data[12:29]))
+
+	if _, _, err := Decode(data); !errors.Is(err, ErrTooManyPixels) {
+		t.Errorf("Decode() error = %v, want %v", err, ErrTooManyPixels)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	opaque := filled(2, 2, color.RGBA{R: 10, A: 255})
+	transparent := filled(2, 2, color.RGBA{})
+
+	tests := []struct {
+		name   string
+		img    *image.RGBA
+		format string
+		want   string
+		err    error
+	}{
+		{name: "jpeg", img: opaque, format: FormatJPEG, want: FormatJPEG},
+		{name: "png", img: transparent, format: FormatPNG, want: FormatPNG},
+		{name: "gif becomes png", img: opaque, format: FormatGIF, want: FormatPNG},
+		{name: "opaque webp becomes jpeg", img: opaque, format: FormatWebP, want: FormatJPEG},
+		{name: "transparent webp becomes png", img: transparent, format: FormatWebP, want: FormatPNG},
+		{name: "unknown format", img: opaque, format: "bmp", err: ErrUnsupportedFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			got, err := Encode(&buf, tt.img, tt.format)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Encode() error = %v, want %v", err, tt.err)
+			}
+			if got != tt.want {
+				t.Fatalf("Encode() = %q, want %q", got, tt.want)
+			}
+			if tt.err != nil {
+				return
+			}
+			if _, format, err := image.DecodeConfig(&buf); err != nil || format != tt.want {
+				t.Errorf("written image is %q, %v, want %q", format, err, tt.want)
+			}
+		})
+	}
+}
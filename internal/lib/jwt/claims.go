@@ -0,0 +1,42 @@
+package jwt
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+var ErrInvalidToken = errors.New("invalid token")
+
+// Claims mirrors the payload auth-service puts into its tokens.
+type Claims struct {
+	Id        int    `json:"id"`
+	Email     string `json:"Email"`
+	Role      string `json:"role"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// ParseUnverified decodes the token payload without checking the signature.
+// Only use it on tokens that were just issued or checked by auth-service.
+func ParseUnverified(token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	var claims Claims
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return nil, ErrInvalidToken
+	}
+	if claims.Id == 0 {
+		return nil, ErrInvalidToken
+	}
+
+	return &claims, nil
+}
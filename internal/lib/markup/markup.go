@@ -0,0 +1,175 @@
+package markup
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/text"
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+const (
+	FormatMarkdown = "markdown"
+	FormatPlain    = "plain"
+)
+
+var ErrUnknownFormat = errors.New("unknown content format")
+
+var paragraphBreak = regexp.MustCompile(`\n\s*\n`)
+
+// Heading is a table of contents entry, Anchor is the id of the heading in the rendered html.
+type Heading struct {
+	Level  int    `json:"level"`
+	Text   string `json:"text"`
+	Anchor string `json:"anchor"`
+}
+
+var markdown = goldmark.New(
+	goldmark.WithExtensions(extension.Table, extension.Strikethrough, extension.Linkify),
+)
+
+// policy is the allow-list applied to every rendered document. Raw html in markdown is already dropped by the
+// renderer, the policy is what actually guarantees nothing else gets through.
+var policy = newPolicy()
+
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("id").Matching(regexp.MustCompile(`^[\p{L}\p{N}_-]+$`)).OnElements("h1", "h2", "h3", "h4", "h5", "h6")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+-]+$`)).OnElements("code")
+	return p
+}
+
+// Render converts text to sanitized html and collects the table of contents, plain text has no headings.
+func Render(format string, source string) (string, []Heading, error) {
+	switch format {
+	case FormatMarkdown:
+		return renderMarkdown([]byte(source))
+	case FormatPlain:
+		return renderPlain(source), nil, nil
+	default:
+		return "", nil, fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+}
+
+func renderMarkdown(source []byte) (string, []Heading, error) {
+	doc := markdown.Parser().Parse(text.NewReader(source))
+
+	ids := newAnchors()
+	var toc []Heading
+	err := ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		heading, ok := n.(*ast.Heading)
+		if !ok || !entering {
+			return ast.WalkContinue, nil
+		}
+
+		entry := Heading{
+			Level: heading.Level,
+			Text:  strings.TrimSpace(plainText(heading, source)),
+		}
+		entry.Anchor = ids.next(entry.Text)
+		heading.SetAttributeString("id", []byte(entry.Anchor))
+		toc = append(toc, entry)
+
+		return ast.WalkSkipChildren, nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	var buf bytes.Buffer
+	if err = markdown.Renderer().Render(&buf, source, doc); err != nil {
+		return "", nil, err
+	}
+
+	return policy.Sanitize(buf.String()), toc, nil
+}
+
+// renderPlain escapes the text, blank lines separate paragraphs and single line breaks are kept.
+func renderPlain(source string) string {
+	source = strings.ReplaceAll(source, "\r\n", "\n")
+
+	var buf strings.Builder
+	for _, paragraph := range paragraphBreak.Split(source, -1) {
+		paragraph = strings.TrimSpace(paragraph)
+		if paragraph == "" {
+			continue
+		}
+
+		buf.WriteString("<p>")
+		buf.WriteString(strings.ReplaceAll(html.EscapeString(paragraph), "\n", "<br>\n"))
+		buf.WriteString("</p>\n")
+	}
+
+	return buf.String()
+}
+
+func plainText(n ast.Node, source []byte) string {
+	var buf strings.Builder
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		switch c := c.(type) {
+		case *ast.Text:
+			buf.Write(c.Segment.Value(source))
+			if c.SoftLineBreak() || c.HardLineBreak() {
+				buf.WriteByte(' ')
+			}
+		case *ast.String:
+			buf.Write(c.Value)
+		default:
+			buf.WriteString(plainText(c, source))
+		}
+	}
+	return buf.String()
+}
+
+// anchors generates heading ids from their text. Unlike the goldmark default it keeps non latin letters,
+// so russian headings don't all become "heading", "heading-1" and so on, and ignores the inline markup.
+type anchors struct {
+	used map[string]bool
+}
+
+func newAnchors() *anchors {
+	return &anchors{
+		used: map[string]bool{},
+	}
+}
+
+func (a *anchors) next(text string) string {
+	var buf strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_':
+			if dash && buf.Len() > 0 {
+				buf.WriteByte('-')
+			}
+			dash = false
+			buf.WriteRune(r)
+		case unicode.IsSpace(r) || r == '-':
+			dash = true
+		}
+	}
+
+	id := buf.String()
+	if id == "" {
+		id = "section"
+	}
+	if !a.used[id] {
+		a.used[id] = true
+		return id
+	}
+
+	for i := 1; ; i++ {
+		candidate := fmt.Sprintf("%s-%d", id, i)
+		if !a.used[candidate] {
+			a.used[candidate] = true
+			return candidate
+		}
+	}
+}
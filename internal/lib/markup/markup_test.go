@@ -0,0 +1,110 @@
+package markup
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRenderMarkdownToc(t *testing.T) {
+	source := "# Посадка томатов\n\nText\n\n## Полив\n\n## Полив\n\n### **Bold** `code` title\n\n## Полив 1\n\n# !!!\n"
+
+	html, toc, err := Render(FormatMarkdown, source)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	want := []Heading{
+		{Level: 1, Text: "Посадка томатов", Anchor: "посадка-томатов"},
+		{Level: 2, Text: "Полив", Anchor: "полив"},
+		{Level: 2, Text: "Полив", Anchor: "полив-1"},
+		{Level: 3, Text: "Bold code title", Anchor: "bold-code-title"},
+		{Level: 2, Text: "Полив 1", Anchor: "полив-1-1"},
+		{Level: 1, Text: "!!!", Anchor: "section"},
+	}
+	if !reflect.DeepEqual(toc, want) {
+		t.Errorf("Render() toc = %+v, want %+v", toc, want)
+	}
+
+	for _, heading := range want {
+		if !strings.Contains(html, `id="`+heading.Anchor+`"`) {
+			t.Errorf("Render() html has no heading with id %q:\n%s", heading.Anchor, html)
+		}
+	}
+}
+
+func TestRenderMarkdownSanitizes(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		absent []string
+	}{
+		{name: "script block", source: "<script>alert(1)</script>\n\ntext", absent: []string{"<script", "alert(1)"}},
+		{name: "inline html", source: `text <img src=x onerror="alert(1)"> more`, absent: []string{"<img", "onerror"}},
+		{name: "javascript link", source: "[click](javascript:alert(1))", absent: []string{"javascript:"}},
+		{name: "raw iframe", source: `<iframe src="https://example.com"></iframe>`, absent: []string{"<iframe"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html, _, err := Render(FormatMarkdown, tt.source)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			for _, s := range tt.absent {
+				if strings.Contains(html, s) {
+					t.Errorf("Render() = %q, contains %q", html, s)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderMarkdownKeepsFormatting(t *testing.T) {
+	html, _, err := Render(FormatMarkdown, "**bold** [link](https://example.com)\n\n```go\ncode\n```\n")
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	for _, s := range []string{"<strong>bold</strong>", `href="https://example.com"`, `class="language-go"`} {
+		if !strings.Contains(html, s) {
+			t.Errorf("Render() = %q, want it to contain %q", html, s)
+		}
+	}
+}
+
+func TestRenderPlain(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		want   string
+	}{
+		{name: "empty", source: "", want: ""},
+		{name: "escapes html", source: `<b>"bold"</b> & co`, want: "<p>&lt;b&gt;&#34;bold&#34;&lt;/b&gt; &amp; co</p>\n"},
+		{name: "paragraphs", source: "one\n\n  \ntwo", want: "<p>one</p>\n<p>two</p>\n"},
+		{name: "line breaks", source: "one\r\ntwo", want: "<p>one<br>\ntwo</p>\n"},
+		{name: "markdown is not rendered", source: "# title", want: "<p># title</p>\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html, toc, err := Render(FormatPlain, tt.source)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if html != tt.want {
+				t.Errorf("Render() = %q, want %q", html, tt.want)
+			}
+			if toc != nil {
+				t.Errorf("Render() toc = %+v, want none", toc)
+			}
+		})
+	}
+}
+
+func TestRenderUnknownFormat(t *testing.T) {
+	if _, _, err := Render("html", "text"); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("Render() error = %v, want %v", err, ErrUnknownFormat)
+	}
+}
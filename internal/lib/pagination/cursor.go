@@ -0,0 +1,54 @@
+package pagination
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is a keyset position: the sort column value and id of the last row of the previous page.
+// Sort, Desc and Query record the listing it was issued for, a cursor only makes sense for the same order.
+type Cursor struct {
+	Value string `json:"v"`
+	Id    int    `json:"id"`
+	Sort  string `json:"s"`
+	Desc  bool   `json:"d,omitempty"`
+	Query string `json:"q,omitempty"`
+}
+
+// QueryHash keeps the search query out of the cursor, only whether it changed matters.
+func QueryHash(query *string) string {
+	if query == nil {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(*query))
+	return hex.EncodeToString(sum[:8])
+}
+
+// Matches reports whether the cursor was issued for a listing with the same order and search query.
+func (c *Cursor) Matches(sort string, desc bool, query *string) bool {
+	return c.Sort == sort && c.Desc == desc && c.Query == QueryHash(query)
+}
+
+func EncodeCursor(c *Cursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCursor(s string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c Cursor
+	if err = json.Unmarshal(data, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,85 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor Cursor
+	}{
+		{name: "empty value", cursor: Cursor{Id: 1}},
+		{name: "timestamp", cursor: Cursor{Value: "2025-06-01T10:00:00.123456Z", Id: 42}},
+		{name: "unicode title", cursor: Cursor{Value: "Томаты и перцы", Id: 7}},
+		{name: "url unsafe characters", cursor: Cursor{Value: "a/b+c?d=e&f", Id: 3}},
+		{name: "listing", cursor: Cursor{Value: "0.5", Id: 9, Sort: "rank", Desc: true, Query: "0123456789abcdef"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeCursor(EncodeCursor(&tt.cursor))
+			if err != nil {
+				t.Fatalf("DecodeCursor() error = %v", err)
+			}
+			if *got != tt.cursor {
+				t.Errorf("DecodeCursor() = %+v, want %+v", *got, tt.cursor)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorMalformed(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "not base64", input: "!!!"},
+		{name: "padded base64", input: base64.URLEncoding.EncodeToString([]byte(`{"v":"x","id":1}`))},
+		{name: "not json", input: base64.RawURLEncoding.EncodeToString([]byte("cursor"))},
+		{name: "wrong id type", input: base64.RawURLEncoding.EncodeToString([]byte(`{"v":"x","id":"1"}`))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeCursor(tt.input); !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("DecodeCursor(%q) error = %v, want %v", tt.input, err, ErrInvalidCursor)
+			}
+		})
+	}
+}
+
+func TestCursorMatches(t *testing.T) {
+	tomato, potato := "tomato", "potato"
+	createdAt := Cursor{Value: "2025-06-01T10:00:00Z", Id: 1, Sort: "created_at", Desc: true}
+	title := Cursor{Value: "Tomato", Id: 2, Sort: "title"}
+	rank := Cursor{Value: "0.25", Id: 3, Sort: "rank", Desc: true, Query: QueryHash(&tomato)}
+
+	tests := []struct {
+		name   string
+		cursor Cursor
+		sort   string
+		desc   bool
+		query  *string
+		want   bool
+	}{
+		{name: "same listing", cursor: createdAt, sort: "created_at", desc: true, want: true},
+		{name: "created_at cursor with title sort", cursor: createdAt, sort: "title", desc: true, want: false},
+		{name: "title cursor with rank sort", cursor: title, sort: "rank", query: &tomato, want: false},
+		{name: "direction flipped", cursor: createdAt, sort: "created_at", desc: false, want: false},
+		{name: "same search", cursor: rank, sort: "rank", desc: true, query: &tomato, want: true},
+		{name: "different search", cursor: rank, sort: "rank", desc: true, query: &potato, want: false},
+		{name: "search dropped", cursor: rank, sort: "rank", desc: true, want: false},
+		{name: "search added", cursor: title, sort: "title", query: &tomato, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cursor.Matches(tt.sort, tt.desc, tt.query); got != tt.want {
+				t.Errorf("Matches(%q, %t) = %t, want %t", tt.sort, tt.desc, got, tt.want)
+			}
+		})
+	}
+}
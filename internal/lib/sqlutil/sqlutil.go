@@ -0,0 +1,17 @@
+package sqlutil
+
+// NullIfEmpty stores an empty optional text column as NULL.
+func NullIfEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// NullIfZero stores a zero optional reference as NULL.
+func NullIfZero(id int) interface{} {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
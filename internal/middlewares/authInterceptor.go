@@ -0,0 +1,61 @@
+package middlewares
+
+import (
+	"context"
+	"github.com/nogavadu/articles-service/internal/lib/identity"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// AuthInterceptor is the gRPC counterpart of AuthMiddleware.
+// Methods listed in public may be called without a token, a token sent to them is still resolved.
+func AuthInterceptor(resolver IdentityResolver, public ...string) grpc.UnaryServerInterceptor {
+	publicMethods := make(map[string]struct{}, len(public))
+	for _, m := range public {
+		publicMethods[m] = struct{}{}
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		_, isPublic := publicMethods[info.FullMethod]
+
+		token, ok := grpcAuthToken(ctx)
+		if !ok {
+			if isPublic {
+				return handler(ctx, req)
+			}
+			return nil, status.Error(codes.Unauthenticated, "invalid auth token")
+		}
+
+		ctx = context.WithValue(ctx, authTokenKey, token)
+
+		userId, err := resolver.UserId(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "invalid auth token")
+		}
+		ctx = identity.WithUserId(ctx, userId)
+
+		return handler(ctx, req)
+	}
+}
+
+func grpcAuthToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	values := md.Get(authTokenKey)
+	if len(values) == 0 {
+		return "", false
+	}
+
+	tokenParts := strings.Split(values[0], " ")
+	if len(tokenParts) != 2 || tokenParts[0] != "Bearer" {
+		return "", false
+	}
+
+	return tokenParts[1], true
+}
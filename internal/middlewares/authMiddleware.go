@@ -4,21 +4,36 @@ import (
 	"context"
 	"github.com/nogavadu/articles-service/internal/lib/api/request"
 	"github.com/nogavadu/articles-service/internal/lib/api/response"
+	"github.com/nogavadu/articles-service/internal/lib/identity"
 	"net/http"
 )
 
 const authTokenKey = "authorization"
 
-func AuthMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		token, err := request.GetAuthToken(r)
-		if err != nil {
-			response.Err(w, r, "invalid auth token", http.StatusUnauthorized)
-			return
-		}
+type IdentityResolver interface {
+	UserId(ctx context.Context) (int, error)
+}
+
+// AuthMiddleware puts the bearer token and the id of the user it belongs to on the request context.
+func AuthMiddleware(resolver IdentityResolver) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, err := request.GetAuthToken(r)
+			if err != nil {
+				response.Err(w, r, "invalid auth token", http.StatusUnauthorized)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), authTokenKey, token)
 
-		ctx := context.WithValue(r.Context(), authTokenKey, token)
+			userId, err := resolver.UserId(ctx)
+			if err != nil {
+				response.Err(w, r, "invalid auth token", http.StatusUnauthorized)
+				return
+			}
+			ctx = identity.WithUserId(ctx, userId)
 
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
 }
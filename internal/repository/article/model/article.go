@@ -6,19 +6,46 @@ type ArticleGetAllParams struct {
 	CropId     *int
 	CategoryId *int
 	Status     int
+	Query      *string
+
+	IncludeDescendants bool
+	IncludeParentCrops bool
+
+	Limit  uint64
+	Sort   string
+	Desc   bool
+	Cursor *ArticleCursor
+}
+
+// ArticleCursor points at the last row of the previous page, Value is typed according to the sort column.
+type ArticleCursor struct {
+	Value interface{}
+	Id    int
 }
 
 type Article struct {
 	Id int `db:"id"`
 	ArticleBody
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	RejectionReason *string   `db:"rejection_reason"`
+	CreatedAt       time.Time `db:"created_at"`
+	UpdatedAt       time.Time `db:"updated_at"`
+
+	// DeletedAt is only selected when listing the trash.
+	DeletedAt *time.Time `db:"deleted_at"`
+
+	// Rank and Snippet are only selected when searching by query.
+	Rank    *float32 `db:"rank"`
+	Snippet *string  `db:"snippet"`
 }
 
+// ArticleBody.Html and Toc are rendered from Text, Toc is a json array of headings.
 type ArticleBody struct {
 	Title     string  `db:"title"`
 	LatinName *string `db:"latin_name"`
 	Text      *string `db:"text"`
+	Format    string  `db:"format"`
+	Html      *string `db:"html"`
+	Toc       []byte  `db:"toc"`
 	Status    int     `db:"status"`
 	Author    *int    `db:"author"`
 }
@@ -27,5 +54,17 @@ type UpdateInput struct {
 	Title     *string `db:"title"`
 	LatinName *string `db:"latin_name"`
 	Text      *string `db:"text"`
+	Format    *string `db:"format"`
 	Status    *int    `db:"status"`
+
+	// ClearLatinName and ClearText set the columns to null, a nil LatinName or Text leaves them as they are.
+	ClearLatinName bool `db:"-"`
+	ClearText      bool `db:"-"`
+
+	// Html set to an empty string clears the rendered html, Toc is only written along with Html.
+	Html *string `db:"html"`
+	Toc  []byte  `db:"toc"`
+
+	// RejectionReason set to an empty string clears the stored reason.
+	RejectionReason *string `db:"rejection_reason"`
 }
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/nogavadu/articles-service/internal/lib/postgresErrors"
+	"github.com/nogavadu/articles-service/internal/lib/sqlutil"
 	"github.com/nogavadu/articles-service/internal/repository"
 	articleRepoModel "github.com/nogavadu/articles-service/internal/repository/article/model"
 	"github.com/nogavadu/platform_common/pkg/db"
@@ -15,10 +17,20 @@ import (
 
 var (
 	ErrAlreadyExists       = errors.New("article already exists")
+	ErrNotFound            = errors.New("article not found")
 	ErrInvalidArguments    = errors.New("invalid arguments")
 	ErrInternalServerError = errors.New("internal server error")
 )
 
+const (
+	// searchConfig must match the text search configuration of the articles.search_vector column.
+	searchConfig = "russian"
+
+	SortRank = "rank"
+)
+
+var rankExpr = fmt.Sprintf("ts_rank(a.search_vector, websearch_to_tsquery('%s', ?))", searchConfig)
+
 type articleRepository struct {
 	dbc db.Client
 }
@@ -40,6 +52,9 @@ func (r *articleRepository) Create(
 			"title",
 			"latin_name",
 			"text",
+			"format",
+			"html",
+			"toc",
 			"author",
 			"status",
 			"created_at",
@@ -49,6 +64,9 @@ func (r *articleRepository) Create(
 			articleBody.Title,
 			articleBody.LatinName,
 			articleBody.Text,
+			articleBody.Format,
+			articleBody.Html,
+			articleBody.Toc,
 			articleBody.Author,
 			articleBody.Status,
 			time.Now(),
@@ -90,27 +108,65 @@ func (r *articleRepository) GetAll(
 			"a.title",
 			"a.latin_name",
 			"a.text",
+			"a.format",
+			"a.html",
+			"a.toc",
 			"a.author",
 			"a.status",
+			"a.rejection_reason",
 			"a.created_at",
 			"a.updated_at",
 		).
 		PlaceholderFormat(sq.Dollar).
 		From("articles AS a")
 
-	if params.CropId != nil || params.CategoryId != nil {
-		builder = builder.InnerJoin("articles_relations AS ar ON a.id = ar.article_id")
+	if params.Query != nil {
+		builder = builder.
+			Column(sq.Expr(rankExpr+" AS rank", *params.Query)).
+			Column(sq.Expr(
+				fmt.Sprintf(
+					"ts_headline('%s', coalesce(a.text, ''), websearch_to_tsquery('%s', ?), "+
+						"'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10') AS snippet",
+					searchConfig, searchConfig,
+				),
+				*params.Query,
+			))
+	}
 
-		if params.CropId != nil && params.CategoryId != nil {
-			builder = builder.Where("ar.crop_id = ? AND ar.category_id = ?", *params.CropId, *params.CategoryId)
-		} else if params.CropId != nil {
-			builder = builder.Where("ar.crop_id = ?", *params.CropId)
-		} else if params.CategoryId != nil {
-			builder = builder.Where("ar.category_id = ?", *params.CategoryId)
+	builder = applyArticleFilters(builder, params)
+
+	sortExpr, sortArgs, placeholder := "a."+params.Sort, []interface{}{}, "?"
+	if params.Sort == SortRank {
+		if params.Query == nil {
+			return nil, fmt.Errorf("%w: rank sort requires a search query", ErrInvalidArguments)
 		}
+		sortExpr, sortArgs, placeholder = rankExpr, []interface{}{*params.Query}, "?::real"
 	}
 
-	queryRaw, args, err := builder.Where(sq.Eq{"a.status": params.Status}).ToSql()
+	direction := "ASC"
+	if params.Desc {
+		direction = "DESC"
+	}
+
+	if params.Cursor != nil {
+		op := ">"
+		if params.Desc {
+			op = "<"
+		}
+		builder = builder.Where(
+			fmt.Sprintf("(%s, a.id) %s (%s, ?)", sortExpr, op, placeholder),
+			append(sortArgs, params.Cursor.Value, params.Cursor.Id)...,
+		)
+	}
+
+	builder = builder.
+		OrderByClause(sortExpr+" "+direction, sortArgs...).
+		OrderBy("a.id " + direction)
+	if params.Limit > 0 {
+		builder = builder.Limit(params.Limit)
+	}
+
+	queryRaw, args, err := builder.ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
 	}
@@ -128,6 +184,81 @@ func (r *articleRepository) GetAll(
 	return articles, nil
 }
 
+func (r *articleRepository) Count(ctx context.Context, params *articleRepoModel.ArticleGetAllParams) (int, error) {
+	builder := sq.
+		Select("COUNT(*)").
+		PlaceholderFormat(sq.Dollar).
+		From("articles AS a")
+
+	queryRaw, args, err := applyArticleFilters(builder, params).ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "articleRepository.Count",
+		QueryRaw: queryRaw,
+	}
+
+	var total int
+	if err = r.dbc.DB().ScanOneContext(ctx, &total, query, args...); err != nil {
+		return 0, fmt.Errorf("failed to count articles: %s: %w", ErrInternalServerError, err)
+	}
+
+	return total, nil
+}
+
+// applyArticleFilters uses EXISTS instead of a join so an article related to several
+// crop/category pairs is returned once, otherwise keyset pagination would see duplicates.
+func applyArticleFilters(builder sq.SelectBuilder, params *articleRepoModel.ArticleGetAllParams) sq.SelectBuilder {
+	if params.CropId != nil || params.CategoryId != nil {
+		relations := sq.
+			Select("1").
+			From("articles_relations AS ar").
+			Where("ar.article_id = a.id")
+
+		if params.CropId != nil && params.IncludeParentCrops {
+			ancestors := sq.
+				Select("id").
+				Prefix(`WITH RECURSIVE ancestors AS (
+					SELECT id, parent_id FROM crops WHERE id = ?
+					UNION
+					SELECT c.id, c.parent_id FROM crops AS c JOIN ancestors AS a ON c.id = a.parent_id WHERE c.deleted_at IS NULL
+				)`, *params.CropId).
+				From("ancestors")
+
+			relations = relations.Where(sq.Expr("ar.crop_id IN (?)", ancestors))
+		} else if params.CropId != nil {
+			relations = relations.Where(sq.Eq{"ar.crop_id": *params.CropId})
+		}
+		if params.CategoryId != nil && params.IncludeDescendants {
+			descendants := sq.
+				Select("id").
+				Prefix(`WITH RECURSIVE descendants AS (
+					SELECT id FROM categories WHERE id = ?
+					UNION
+					SELECT c.id FROM categories AS c JOIN descendants AS d ON c.parent_id = d.id WHERE c.deleted_at IS NULL
+				)`, *params.CategoryId).
+				From("descendants")
+
+			relations = relations.Where(sq.Expr("ar.category_id IN (?)", descendants))
+		} else if params.CategoryId != nil {
+			relations = relations.Where(sq.Eq{"ar.category_id": *params.CategoryId})
+		}
+
+		builder = builder.Where(sq.Expr("EXISTS (?)", relations))
+	}
+
+	if params.Query != nil {
+		builder = builder.Where(
+			fmt.Sprintf("a.search_vector @@ websearch_to_tsquery('%s', ?)", searchConfig),
+			*params.Query,
+		)
+	}
+
+	return builder.Where(sq.Eq{"a.status": params.Status, "a.deleted_at": nil})
+}
+
 func (r *articleRepository) GetById(ctx context.Context, id int) (*articleRepoModel.Article, error) {
 	queryRaw, args, err := sq.
 		Select(
@@ -135,14 +266,18 @@ func (r *articleRepository) GetById(ctx context.Context, id int) (*articleRepoMo
 			"title",
 			"latin_name",
 			"text",
+			"format",
+			"html",
+			"toc",
 			"author",
 			"status",
+			"rejection_reason",
 			"created_at",
 			"updated_at",
 		).
 		PlaceholderFormat(sq.Dollar).
 		From("articles").
-		Where(sq.Eq{"id": id}).
+		Where(sq.Eq{"id": id, "deleted_at": nil}).
 		Limit(1).
 		ToSql()
 	if err != nil {
@@ -156,6 +291,9 @@ func (r *articleRepository) GetById(ctx context.Context, id int) (*articleRepoMo
 
 	var article articleRepoModel.Article
 	if err = r.dbc.DB().ScanOneContext(ctx, &article, query, args...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
 		return nil, fmt.Errorf("failed to get article by id: %s: %w", ErrInternalServerError, err)
 	}
 
@@ -172,19 +310,33 @@ func (r *articleRepository) Update(ctx context.Context, id int, input *articleRe
 	}
 	if input.LatinName != nil {
 		values["latin_name"] = input.LatinName
+	} else if input.ClearLatinName {
+		values["latin_name"] = nil
 	}
 	if input.Text != nil {
 		values["text"] = input.Text
+	} else if input.ClearText {
+		values["text"] = nil
+	}
+	if input.Format != nil {
+		values["format"] = input.Format
+	}
+	if input.Html != nil {
+		values["html"] = sqlutil.NullIfEmpty(*input.Html)
+		values["toc"] = input.Toc
 	}
 	if input.Status != nil {
 		values["status"] = input.Status
 	}
+	if input.RejectionReason != nil {
+		values["rejection_reason"] = sqlutil.NullIfEmpty(*input.RejectionReason)
+	}
 
 	queryRaw, args, err := sq.
 		Update("articles").
 		PlaceholderFormat(sq.Dollar).
 		SetMap(values).
-		Where(sq.Eq{"id": id}).
+		Where(sq.Eq{"id": id, "deleted_at": nil}).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("failed to build query: %s: %w", ErrInternalServerError, err)
@@ -195,18 +347,24 @@ func (r *articleRepository) Update(ctx context.Context, id int, input *articleRe
 		QueryRaw: queryRaw,
 	}
 
-	if _, err = r.dbc.DB().ExecContext(ctx, query, args...); err != nil {
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
 		return fmt.Errorf("failed to update article: %s: %w", ErrInternalServerError, err)
 	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
 
 	return nil
 }
 
+// Delete moves the article to the trash, relations are kept so a restore brings them back.
 func (r *articleRepository) Delete(ctx context.Context, id int) error {
 	queryRaw, args, err := sq.
-		Delete("articles").
+		Update("articles").
 		PlaceholderFormat(sq.Dollar).
-		Where(sq.Eq{"id": id}).
+		Set("deleted_at", time.Now()).
+		Where(sq.Eq{"id": id, "deleted_at": nil}).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("failed to build query: %s: %w", ErrInternalServerError, err)
@@ -217,9 +375,194 @@ func (r *articleRepository) Delete(ctx context.Context, id int) error {
 		QueryRaw: queryRaw,
 	}
 
-	if _, err = r.dbc.DB().ExecContext(ctx, query, args...); err != nil {
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
 		return fmt.Errorf("failed to delete article: %s: %w", ErrInternalServerError, err)
 	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// GetUnrendered lists the articles after afterId with text whose html hasn't been rendered yet, trashed ones included.
+func (r *articleRepository) GetUnrendered(ctx context.Context, afterId int, limit uint64) ([]articleRepoModel.Article, error) {
+	queryRaw, args, err := sq.
+		Select("id", "text", "format").
+		PlaceholderFormat(sq.Dollar).
+		From("articles").
+		Where(sq.And{
+			sq.Gt{"id": afterId},
+			sq.Eq{"html": nil},
+			sq.NotEq{"text": nil},
+			sq.NotEq{"text": ""},
+		}).
+		OrderBy("id").
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %s: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "articleRepository.GetUnrendered",
+		QueryRaw: queryRaw,
+	}
+
+	var articles []articleRepoModel.Article
+	if err = r.dbc.DB().ScanAllContext(ctx, &articles, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get unrendered articles: %s: %w", ErrInternalServerError, err)
+	}
+
+	return articles, nil
+}
+
+// SetRendered stores the rendered html and toc, unlike Update it leaves updated_at alone.
+func (r *articleRepository) SetRendered(ctx context.Context, id int, html string, toc []byte) error {
+	queryRaw, args, err := sq.
+		Update("articles").
+		PlaceholderFormat(sq.Dollar).
+		Set("html", html).
+		Set("toc", toc).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %s: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "articleRepository.SetRendered",
+		QueryRaw: queryRaw,
+	}
+
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to set rendered article: %s: %w", ErrInternalServerError, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *articleRepository) GetDeleted(ctx context.Context) ([]articleRepoModel.Article, error) {
+	queryRaw, args, err := sq.
+		Select(
+			"id",
+			"title",
+			"latin_name",
+			"text",
+			"format",
+			"html",
+			"toc",
+			"author",
+			"status",
+			"rejection_reason",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+		).
+		PlaceholderFormat(sq.Dollar).
+		From("articles").
+		Where(sq.NotEq{"deleted_at": nil}).
+		OrderBy("deleted_at DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %s: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "articleRepository.GetDeleted",
+		QueryRaw: queryRaw,
+	}
+
+	var articles []articleRepoModel.Article
+	if err = r.dbc.DB().ScanAllContext(ctx, &articles, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get deleted articles: %s: %w", ErrInternalServerError, err)
+	}
+
+	return articles, nil
+}
+
+func (r *articleRepository) Restore(ctx context.Context, id int) error {
+	queryRaw, args, err := sq.
+		Update("articles").
+		PlaceholderFormat(sq.Dollar).
+		Set("deleted_at", nil).
+		Where(sq.Eq{"id": id}).
+		Where(sq.NotEq{"deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %s: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "articleRepository.Restore",
+		QueryRaw: queryRaw,
+	}
+
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to restore article: %s: %w", ErrInternalServerError, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// HardDelete removes a trashed article for good, FK cascades drop its relations.
+func (r *articleRepository) HardDelete(ctx context.Context, id int) error {
+	queryRaw, args, err := sq.
+		Delete("articles").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"id": id}).
+		Where(sq.NotEq{"deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %s: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "articleRepository.HardDelete",
+		QueryRaw: queryRaw,
+	}
+
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to hard delete article: %s: %w", ErrInternalServerError, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
 
 	return nil
 }
+
+// Purge removes trashed articles for good, FK cascades drop their relations.
+func (r *articleRepository) Purge(ctx context.Context, deletedBefore time.Time) ([]int, error) {
+	queryRaw, args, err := sq.
+		Delete("articles").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Lt{"deleted_at": deletedBefore}).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %s: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "articleRepository.Purge",
+		QueryRaw: queryRaw,
+	}
+
+	var ids []int
+	if err = r.dbc.DB().ScanAllContext(ctx, &ids, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to purge articles: %s: %w", ErrInternalServerError, err)
+	}
+
+	return ids, nil
+}
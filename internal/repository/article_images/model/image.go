@@ -0,0 +1,24 @@
+package model
+
+type Image struct {
+	Id        int `db:"id"`
+	ArticleId int `db:"article_id"`
+	Position  int `db:"position"`
+	ImageInfo
+	Img string `db:"img"`
+}
+
+type ImageInfo struct {
+	// MediaId is nil for images added as plain urls before uploads existed.
+	MediaId     *int    `db:"media_id"`
+	Caption     *string `db:"caption"`
+	Alt         *string `db:"alt"`
+	Attribution *string `db:"attribution"`
+}
+
+// UpdateInput fields set to an empty string clear the stored value.
+type UpdateInput struct {
+	Caption     *string `db:"caption"`
+	Alt         *string `db:"alt"`
+	Attribution *string `db:"attribution"`
+}
@@ -5,16 +5,36 @@ import (
 	"errors"
 	"fmt"
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/nogavadu/articles-service/internal/lib/postgresErrors"
+	"github.com/nogavadu/articles-service/internal/lib/sqlutil"
 	"github.com/nogavadu/articles-service/internal/repository"
+	imageRepoModel "github.com/nogavadu/articles-service/internal/repository/article_images/model"
 	"github.com/nogavadu/platform_common/pkg/db"
 )
 
 var (
+	ErrNotFound            = errors.New("article image not found")
 	ErrAlreadyExists       = errors.New("article already exists")
 	ErrInvalidArguments    = errors.New("invalid arguments")
 	ErrInternalServerError = errors.New("internal server error")
 )
 
+// imgColumn resolves the uploaded image to its url, images added before uploads existed keep their url.
+const imgColumn = "COALESCE(m.url, i.img) AS img"
+
+var imageColumns = []string{
+	"i.id",
+	"i.article_id",
+	"i.position",
+	"i.media_id",
+	"i.caption",
+	"i.alt",
+	"i.attribution",
+	imgColumn,
+}
+
 type articleImagesRepository struct {
 	dbc db.Client
 }
@@ -25,14 +45,15 @@ func New(dbc db.Client) repository.ArticleImagesRepository {
 	}
 }
 
-func (r *articleImagesRepository) CreateBulk(ctx context.Context, articleId int, images []string) error {
+// CreateBulk adds the images in the given order, the article must have no images yet.
+func (r *articleImagesRepository) CreateBulk(ctx context.Context, articleId int, mediaIds []int) error {
 	builder := sq.
 		Insert("articles_images").
 		PlaceholderFormat(sq.Dollar).
-		Columns("article_id", "img")
+		Columns("article_id", "media_id", "position")
 
-	for _, img := range images {
-		builder = builder.Values(articleId, img)
+	for position, mediaId := range mediaIds {
+		builder = builder.Values(articleId, mediaId, position)
 	}
 
 	queryRow, args, err := builder.ToSql()
@@ -46,18 +67,92 @@ func (r *articleImagesRepository) CreateBulk(ctx context.Context, articleId int,
 	}
 
 	if _, err = r.dbc.DB().ExecContext(ctx, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == postgresErrors.InvalidForeignKeyErrCode {
+			return fmt.Errorf("failed to create article images: %w: %w", ErrInvalidArguments, err)
+		}
+
 		return fmt.Errorf("failed to create article images: %s: %w", ErrInternalServerError, err)
 	}
 
 	return nil
 }
 
-func (r *articleImagesRepository) GetAll(ctx context.Context, articleId int) ([]string, error) {
+// Create appends the image after the last image of the article.
+func (r *articleImagesRepository) Create(ctx context.Context, articleId int, info *imageRepoModel.ImageInfo) (int, error) {
 	queryRaw, args, err := sq.
-		Select("img").
+		Insert("articles_images").
 		PlaceholderFormat(sq.Dollar).
-		From("articles_images").
-		Where(sq.Eq{"article_id": articleId}).
+		Columns("article_id", "media_id", "caption", "alt", "attribution", "position").
+		Values(
+			articleId,
+			info.MediaId,
+			info.Caption,
+			info.Alt,
+			info.Attribution,
+			sq.Expr("(SELECT COALESCE(MAX(position) + 1, 0) FROM articles_images WHERE article_id = ?)", articleId),
+		).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %s: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "articleImagesRepository.Create",
+		QueryRaw: queryRaw,
+	}
+
+	var id int
+	if err = r.dbc.DB().ScanOneContext(ctx, &id, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == postgresErrors.InvalidForeignKeyErrCode {
+			return 0, fmt.Errorf("failed to create article image: %w: %w", ErrInvalidArguments, err)
+		}
+
+		return 0, fmt.Errorf("failed to create article image: %s: %w", ErrInternalServerError, err)
+	}
+
+	return id, nil
+}
+
+func (r *articleImagesRepository) GetById(ctx context.Context, id int) (*imageRepoModel.Image, error) {
+	queryRaw, args, err := sq.
+		Select(imageColumns...).
+		PlaceholderFormat(sq.Dollar).
+		From("articles_images AS i").
+		LeftJoin("media AS m ON m.id = i.media_id").
+		Where(sq.Eq{"i.id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %s: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "articleImagesRepository.GetById",
+		QueryRaw: queryRaw,
+	}
+
+	var img imageRepoModel.Image
+	if err = r.dbc.DB().ScanOneContext(ctx, &img, query, args...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("failed to get article image: %w: %w", ErrNotFound, err)
+		}
+
+		return nil, fmt.Errorf("failed to get article image: %s: %w", ErrInternalServerError, err)
+	}
+
+	return &img, nil
+}
+
+func (r *articleImagesRepository) GetAll(ctx context.Context, articleId int) ([]imageRepoModel.Image, error) {
+	queryRaw, args, err := sq.
+		Select(imageColumns...).
+		PlaceholderFormat(sq.Dollar).
+		From("articles_images AS i").
+		LeftJoin("media AS m ON m.id = i.media_id").
+		Where(sq.Eq{"i.article_id": articleId}).
+		OrderBy("i.position", "i.id").
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build query: %s: %w", ErrInternalServerError, err)
@@ -68,7 +163,7 @@ func (r *articleImagesRepository) GetAll(ctx context.Context, articleId int) ([]
 		QueryRaw: queryRaw,
 	}
 
-	var imgs []string
+	var imgs []imageRepoModel.Image
 	if err = r.dbc.DB().ScanAllContext(ctx, &imgs, query, args...); err != nil {
 		return nil, fmt.Errorf("failed to get article images: %s: %w", ErrInternalServerError, err)
 	}
@@ -76,6 +171,137 @@ func (r *articleImagesRepository) GetAll(ctx context.Context, articleId int) ([]
 	return imgs, nil
 }
 
+func (r *articleImagesRepository) GetAllByArticleIds(ctx context.Context, articleIds []int) (map[int][]imageRepoModel.Image, error) {
+	images := make(map[int][]imageRepoModel.Image, len(articleIds))
+	if len(articleIds) == 0 {
+		return images, nil
+	}
+
+	queryRaw, args, err := sq.
+		Select(imageColumns...).
+		PlaceholderFormat(sq.Dollar).
+		From("articles_images AS i").
+		LeftJoin("media AS m ON m.id = i.media_id").
+		Where(sq.Eq{"i.article_id": articleIds}).
+		OrderBy("i.position", "i.id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %s: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "articleImagesRepository.GetAllByArticleIds",
+		QueryRaw: queryRaw,
+	}
+
+	var rows []imageRepoModel.Image
+	if err = r.dbc.DB().ScanAllContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get article images: %s: %w", ErrInternalServerError, err)
+	}
+
+	for _, row := range rows {
+		images[row.ArticleId] = append(images[row.ArticleId], row)
+	}
+
+	return images, nil
+}
+
+func (r *articleImagesRepository) Update(ctx context.Context, id int, input *imageRepoModel.UpdateInput) error {
+	values := map[string]interface{}{}
+
+	if input.Caption != nil {
+		values["caption"] = sqlutil.NullIfEmpty(*input.Caption)
+	}
+	if input.Alt != nil {
+		values["alt"] = sqlutil.NullIfEmpty(*input.Alt)
+	}
+	if input.Attribution != nil {
+		values["attribution"] = sqlutil.NullIfEmpty(*input.Attribution)
+	}
+	if len(values) == 0 {
+		return nil
+	}
+
+	queryRaw, args, err := sq.
+		Update("articles_images").
+		PlaceholderFormat(sq.Dollar).
+		SetMap(values).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %s: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "articleImagesRepository.Update",
+		QueryRaw: queryRaw,
+	}
+
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update article image: %s: %w", ErrInternalServerError, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// SetPositions numbers the images of the article in the order of imageIds starting at 0.
+func (r *articleImagesRepository) SetPositions(ctx context.Context, articleId int, imageIds []int) error {
+	if len(imageIds) == 0 {
+		return nil
+	}
+
+	queryRaw, args, err := sq.
+		Update("articles_images").
+		PlaceholderFormat(sq.Dollar).
+		Set("position", sq.Expr("array_position(?::int[], id) - 1", imageIds)).
+		Where(sq.Eq{"article_id": articleId, "id": imageIds}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %s: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "articleImagesRepository.SetPositions",
+		QueryRaw: queryRaw,
+	}
+
+	if _, err = r.dbc.DB().ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to set article image positions: %s: %w", ErrInternalServerError, err)
+	}
+
+	return nil
+}
+
+func (r *articleImagesRepository) Delete(ctx context.Context, id int) error {
+	queryRaw, args, err := sq.
+		Delete("articles_images").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %s: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "articleImagesRepository.Delete",
+		QueryRaw: queryRaw,
+	}
+
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete article image: %s: %w", ErrInternalServerError, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
 func (r *articleImagesRepository) DeleteBulk(ctx context.Context, articleId int) error {
 	queryRaw, args, err := sq.
 		Delete("articles_images").
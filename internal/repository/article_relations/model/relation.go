@@ -0,0 +1,11 @@
+package model
+
+type Relation struct {
+	CropId     int `db:"crop_id"`
+	CategoryId int `db:"category_id"`
+}
+
+type ArticleRelation struct {
+	ArticleId int `db:"article_id"`
+	Relation
+}
@@ -8,15 +8,21 @@ import (
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/nogavadu/articles-service/internal/lib/postgresErrors"
 	"github.com/nogavadu/articles-service/internal/repository"
+	articleRelationsRepoModel "github.com/nogavadu/articles-service/internal/repository/article_relations/model"
 	"github.com/nogavadu/platform_common/pkg/db"
 )
 
 var (
-	ErrAlreadyExists       = errors.New("article already exists")
+	ErrAlreadyExists       = errors.New("article relation already exists")
+	ErrNotFound            = errors.New("article relation not found")
+	ErrUnlinkedPair        = errors.New("category is not linked to the crop")
 	ErrInvalidArguments    = errors.New("invalid arguments")
 	ErrInternalServerError = errors.New("internal server error")
 )
 
+// PairConstraint keeps every article relation pointing at a crops_categories row.
+const PairConstraint = "articles_relations_crop_category_fkey"
+
 type articleRelationsRepository struct {
 	dbc db.Client
 }
@@ -39,7 +45,7 @@ func (r *articleRelationsRepository) Create(ctx context.Context, cropId int, cat
 	}
 
 	query := db.Query{
-		Name:     "articleImagesRepository.CreateBulk",
+		Name:     "articleRelationsRepository.Create",
 		QueryRaw: queryRaw,
 	}
 
@@ -49,6 +55,9 @@ func (r *articleRelationsRepository) Create(ctx context.Context, cropId int, cat
 			if pgErr.Code == postgresErrors.AlreadyExistsErrCode {
 				return fmt.Errorf("failed to create article relations: %w: %w", ErrAlreadyExists, err)
 			}
+			if pgErr.Code == postgresErrors.InvalidForeignKeyErrCode && pgErr.ConstraintName == PairConstraint {
+				return fmt.Errorf("failed to create article relations: %w: %w", ErrUnlinkedPair, err)
+			}
 			if pgErr.Code == postgresErrors.InvalidForeignKeyErrCode {
 				return fmt.Errorf("failed to create article relations: %w: %w", ErrInvalidArguments, err)
 			}
@@ -59,3 +68,84 @@ func (r *articleRelationsRepository) Create(ctx context.Context, cropId int, cat
 
 	return nil
 }
+
+func (r *articleRelationsRepository) GetAll(ctx context.Context, articleId int) ([]articleRelationsRepoModel.Relation, error) {
+	queryRaw, args, err := sq.
+		Select("crop_id", "category_id").
+		PlaceholderFormat(sq.Dollar).
+		From("articles_relations").
+		Where(sq.Eq{"article_id": articleId}).
+		OrderBy("crop_id", "category_id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %s: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "articleRelationsRepository.GetAll",
+		QueryRaw: queryRaw,
+	}
+
+	var relations []articleRelationsRepoModel.Relation
+	if err = r.dbc.DB().ScanAllContext(ctx, &relations, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get article relations: %s: %w", ErrInternalServerError, err)
+	}
+
+	return relations, nil
+}
+
+func (r *articleRelationsRepository) Delete(ctx context.Context, cropId int, categoryId int, articleId int) error {
+	queryRaw, args, err := sq.
+		Delete("articles_relations").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{
+			"crop_id":     cropId,
+			"category_id": categoryId,
+			"article_id":  articleId,
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %s: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "articleRelationsRepository.Delete",
+		QueryRaw: queryRaw,
+	}
+
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete article relation: %s: %w", ErrInternalServerError, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *articleRelationsRepository) GetInconsistent(ctx context.Context) ([]articleRelationsRepoModel.ArticleRelation, error) {
+	queryRaw, args, err := sq.
+		Select("ar.article_id", "ar.crop_id", "ar.category_id").
+		PlaceholderFormat(sq.Dollar).
+		From("articles_relations AS ar").
+		LeftJoin("crops_categories AS cc ON cc.crop_id = ar.crop_id AND cc.category_id = ar.category_id").
+		Where(sq.Eq{"cc.crop_id": nil}).
+		OrderBy("ar.article_id", "ar.crop_id", "ar.category_id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %s: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "articleRelationsRepository.GetInconsistent",
+		QueryRaw: queryRaw,
+	}
+
+	var relations []articleRelationsRepoModel.ArticleRelation
+	if err = r.dbc.DB().ScanAllContext(ctx, &relations, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get inconsistent article relations: %s: %w", ErrInternalServerError, err)
+	}
+
+	return relations, nil
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+type Revision struct {
+	Id int `db:"id"`
+	RevisionInfo
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type RevisionInfo struct {
+	ArticleId int     `db:"article_id"`
+	Title     string  `db:"title"`
+	LatinName *string `db:"latin_name"`
+	Text      *string `db:"text"`
+	Format    string  `db:"format"`
+	Author    *int    `db:"author"`
+}
@@ -0,0 +1,156 @@
+package article_revisions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/nogavadu/articles-service/internal/lib/postgresErrors"
+	"github.com/nogavadu/articles-service/internal/repository"
+	revisionRepoModel "github.com/nogavadu/articles-service/internal/repository/article_revisions/model"
+	"github.com/nogavadu/platform_common/pkg/db"
+	"time"
+)
+
+var (
+	ErrNotFound            = errors.New("article revision not found")
+	ErrInvalidArguments    = errors.New("invalid arguments")
+	ErrInternalServerError = errors.New("internal server error")
+)
+
+type articleRevisionsRepository struct {
+	dbc db.Client
+}
+
+func New(dbc db.Client) repository.ArticleRevisionsRepository {
+	return &articleRevisionsRepository{
+		dbc: dbc,
+	}
+}
+
+func (r *articleRevisionsRepository) Create(ctx context.Context, info *revisionRepoModel.RevisionInfo) (int, error) {
+	queryRaw, args, err := sq.
+		Insert("article_revisions").
+		PlaceholderFormat(sq.Dollar).
+		Columns(
+			"article_id",
+			"title",
+			"latin_name",
+			"text",
+			"format",
+			"author",
+			"created_at",
+		).
+		Values(
+			info.ArticleId,
+			info.Title,
+			info.LatinName,
+			info.Text,
+			info.Format,
+			info.Author,
+			time.Now(),
+		).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "articleRevisionsRepository.Create",
+		QueryRaw: queryRaw,
+	}
+
+	var id int
+	if err = r.dbc.DB().ScanOneContext(ctx, &id, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == postgresErrors.InvalidForeignKeyErrCode {
+			return 0, fmt.Errorf("%w: %w", ErrInvalidArguments, err)
+		}
+
+		return 0, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return id, nil
+}
+
+func (r *articleRevisionsRepository) GetAll(ctx context.Context, articleId int) ([]revisionRepoModel.Revision, error) {
+	queryRaw, args, err := sq.
+		Select(
+			"id",
+			"article_id",
+			"title",
+			"latin_name",
+			"text",
+			"format",
+			"author",
+			"created_at",
+		).
+		PlaceholderFormat(sq.Dollar).
+		From("article_revisions").
+		Where(sq.Eq{"article_id": articleId}).
+		OrderBy("id DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "articleRevisionsRepository.GetAll",
+		QueryRaw: queryRaw,
+	}
+
+	var revisions []revisionRepoModel.Revision
+	if err = r.dbc.DB().ScanAllContext(ctx, &revisions, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return revisions, nil
+}
+
+func (r *articleRevisionsRepository) GetById(
+	ctx context.Context,
+	articleId int,
+	id int,
+) (*revisionRepoModel.Revision, error) {
+	queryRaw, args, err := sq.
+		Select(
+			"id",
+			"article_id",
+			"title",
+			"latin_name",
+			"text",
+			"format",
+			"author",
+			"created_at",
+		).
+		PlaceholderFormat(sq.Dollar).
+		From("article_revisions").
+		Where(sq.Eq{
+			"id":         id,
+			"article_id": articleId,
+		}).
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "articleRevisionsRepository.GetById",
+		QueryRaw: queryRaw,
+	}
+
+	var revision revisionRepoModel.Revision
+	if err = r.dbc.DB().ScanOneContext(ctx, &revision, query, args...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return &revision, nil
+}
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+type Entry struct {
+	Id int64 `db:"id"`
+	EntryInfo
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type EntryInfo struct {
+	Actor      *int   `db:"actor"`
+	EntityType string `db:"entity_type"`
+	EntityId   int    `db:"entity_id"`
+	Action     string `db:"action"`
+	Before     []byte `db:"before"`
+	After      []byte `db:"after"`
+}
+
+type GetAllParams struct {
+	EntityType *string
+	EntityId   *int
+	Actor      *int
+	From       *time.Time
+	To         *time.Time
+	Limit      uint64
+}
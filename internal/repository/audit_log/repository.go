@@ -0,0 +1,118 @@
+package audit_log
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/nogavadu/articles-service/internal/repository"
+	auditRepoModel "github.com/nogavadu/articles-service/internal/repository/audit_log/model"
+	"github.com/nogavadu/platform_common/pkg/db"
+	"time"
+)
+
+var (
+	ErrInternalServerError = errors.New("internal server error")
+)
+
+type auditLogRepository struct {
+	dbc db.Client
+}
+
+func New(dbc db.Client) repository.AuditLogRepository {
+	return &auditLogRepository{
+		dbc: dbc,
+	}
+}
+
+func (r *auditLogRepository) Create(ctx context.Context, info *auditRepoModel.EntryInfo) (int64, error) {
+	queryRaw, args, err := sq.
+		Insert("audit_log").
+		PlaceholderFormat(sq.Dollar).
+		Columns(
+			"actor",
+			"entity_type",
+			"entity_id",
+			"action",
+			"before",
+			"after",
+			"created_at",
+		).
+		Values(
+			info.Actor,
+			info.EntityType,
+			info.EntityId,
+			info.Action,
+			info.Before,
+			info.After,
+			time.Now(),
+		).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "auditLogRepository.Create",
+		QueryRaw: queryRaw,
+	}
+
+	var id int64
+	if err = r.dbc.DB().ScanOneContext(ctx, &id, query, args...); err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return id, nil
+}
+
+func (r *auditLogRepository) GetAll(ctx context.Context, params *auditRepoModel.GetAllParams) ([]auditRepoModel.Entry, error) {
+	builder := sq.
+		Select(
+			"id",
+			"actor",
+			"entity_type",
+			"entity_id",
+			"action",
+			"before",
+			"after",
+			"created_at",
+		).
+		PlaceholderFormat(sq.Dollar).
+		From("audit_log").
+		OrderBy("created_at DESC", "id DESC").
+		Limit(params.Limit)
+
+	if params.EntityType != nil {
+		builder = builder.Where(sq.Eq{"entity_type": *params.EntityType})
+	}
+	if params.EntityId != nil {
+		builder = builder.Where(sq.Eq{"entity_id": *params.EntityId})
+	}
+	if params.Actor != nil {
+		builder = builder.Where(sq.Eq{"actor": *params.Actor})
+	}
+	if params.From != nil {
+		builder = builder.Where(sq.GtOrEq{"created_at": *params.From})
+	}
+	if params.To != nil {
+		builder = builder.Where(sq.Lt{"created_at": *params.To})
+	}
+
+	queryRaw, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "auditLogRepository.GetAll",
+		QueryRaw: queryRaw,
+	}
+
+	var entries []auditRepoModel.Entry
+	if err = r.dbc.DB().ScanAllContext(ctx, &entries, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return entries, nil
+}
@@ -10,21 +10,31 @@ type CategoryGetAllParams struct {
 type Category struct {
 	ID int `db:"id"`
 	CategoryInfo
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	RejectionReason *string   `db:"rejection_reason"`
+	CreatedAt       time.Time `db:"created_at"`
+	UpdatedAt       time.Time `db:"updated_at"`
+
+	// DeletedAt is only selected when listing the trash.
+	DeletedAt *time.Time `db:"deleted_at"`
 }
 
 type CategoryInfo struct {
 	Name        string  `db:"name"`
 	Description *string `db:"description"`
 	Icon        *string `db:"icon"`
+	IconId      *int    `db:"icon_id"`
 	Status      int     `db:"status"`
 	Author      *int    `db:"author"`
+	ParentId    *int    `db:"parent_id"`
+	Position    int     `db:"position"`
 }
 
 type UpdateInput struct {
 	Name        *string `db:"name"`
 	Description *string `db:"description"`
-	Icon        *string `db:"icon"`
+	IconId      *int    `db:"icon_id"`
 	Status      *int    `db:"status"`
+
+	// RejectionReason set to an empty string clears the stored reason.
+	RejectionReason *string `db:"rejection_reason"`
 }
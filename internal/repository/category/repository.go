@@ -8,6 +8,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/nogavadu/articles-service/internal/lib/postgresErrors"
+	"github.com/nogavadu/articles-service/internal/lib/sqlutil"
 	"github.com/nogavadu/articles-service/internal/repository"
 	categoryRepoModel "github.com/nogavadu/articles-service/internal/repository/category/model"
 	"github.com/nogavadu/platform_common/pkg/db"
@@ -21,6 +22,9 @@ var (
 	ErrInternalServerError = errors.New("internal server error")
 )
 
+// iconColumn resolves the uploaded icon to its url, categories created before uploads existed keep their url.
+const iconColumn = "COALESCE((SELECT url FROM media WHERE media.id = categories.icon_id), categories.icon) AS icon"
+
 type categoryRepository struct {
 	dbc db.Client
 }
@@ -38,18 +42,22 @@ func (r *categoryRepository) Create(ctx context.Context, info *categoryRepoModel
 		Columns(
 			"name",
 			"description",
-			"icon",
+			"icon_id",
 			"author",
 			"status",
+			"parent_id",
+			"position",
 			"created_at",
 			"updated_at",
 		).
 		Values(
 			info.Name,
 			info.Description,
-			info.Icon,
+			info.IconId,
 			info.Author,
 			info.Status,
+			info.ParentId,
+			info.Position,
 			time.Now(),
 			time.Now(),
 		).
@@ -88,9 +96,13 @@ func (r *categoryRepository) GetAll(
 			"c.id",
 			"c.name",
 			"c.description",
-			"c.icon",
+			"COALESCE((SELECT url FROM media WHERE media.id = c.icon_id), c.icon) AS icon",
+			"c.icon_id",
 			"c.author",
 			"c.status",
+			"c.parent_id",
+			"c.position",
+			"c.rejection_reason",
 			"c.created_at",
 			"c.updated_at",
 		).
@@ -104,8 +116,9 @@ func (r *categoryRepository) GetAll(
 	}
 
 	builder = builder.
-		Where(sq.Eq{"c.status": params.Status}).
-		GroupBy("c.id", "c.name")
+		Where(sq.Eq{"c.status": params.Status, "c.deleted_at": nil}).
+		GroupBy("c.id", "c.name").
+		OrderBy("c.position", "c.id")
 
 	queryRaw, args, err := builder.ToSql()
 	if err != nil {
@@ -131,15 +144,19 @@ func (r *categoryRepository) GetById(ctx context.Context, id int) (*categoryRepo
 			"id",
 			"name",
 			"description",
-			"icon",
+			iconColumn,
+			"icon_id",
 			"author",
 			"status",
+			"parent_id",
+			"position",
+			"rejection_reason",
 			"created_at",
 			"updated_at",
 		).
 		PlaceholderFormat(sq.Dollar).
 		From("categories").
-		Where(sq.Eq{"id": id}).
+		Where(sq.Eq{"id": id, "deleted_at": nil}).
 		Limit(1).
 		ToSql()
 	if err != nil {
@@ -174,18 +191,23 @@ func (r *categoryRepository) Update(ctx context.Context, id int, input *category
 	if input.Description != nil {
 		values["description"] = *input.Description
 	}
-	if input.Icon != nil {
-		values["icon"] = *input.Icon
+	if input.IconId != nil {
+		// The legacy url is dropped as well, otherwise it would show up again once the icon is removed.
+		values["icon_id"] = sqlutil.NullIfZero(*input.IconId)
+		values["icon"] = nil
 	}
 	if input.Status != nil {
 		values["status"] = *input.Status
 	}
+	if input.RejectionReason != nil {
+		values["rejection_reason"] = sqlutil.NullIfEmpty(*input.RejectionReason)
+	}
 
 	queryRaw, args, err := sq.
 		Update("categories").
 		PlaceholderFormat(sq.Dollar).
 		SetMap(values).
-		Where(sq.Eq{"id": id}).
+		Where(sq.Eq{"id": id, "deleted_at": nil}).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
@@ -196,31 +218,265 @@ func (r *categoryRepository) Update(ctx context.Context, id int, input *category
 		QueryRaw: queryRaw,
 	}
 
-	if _, err = r.dbc.DB().ExecContext(ctx, query, args...); err != nil {
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == postgresErrors.InvalidForeignKeyErrCode {
+			return fmt.Errorf("%w: %w", ErrInvalidArguments, err)
+		}
+
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// Move puts the category under parentId, or at the top level when parentId is nil, its subtree moves along.
+func (r *categoryRepository) Move(ctx context.Context, id int, parentId *int, position int) error {
+	queryRaw, args, err := sq.
+		Update("categories").
+		PlaceholderFormat(sq.Dollar).
+		SetMap(map[string]interface{}{
+			"parent_id":  parentId,
+			"position":   position,
+			"updated_at": time.Now(),
+		}).
+		Where(sq.Eq{"id": id, "deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "categoryRepository.Move",
+		QueryRaw: queryRaw,
+	}
+
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == postgresErrors.InvalidForeignKeyErrCode {
+			return fmt.Errorf("%w: %w", ErrInvalidArguments, err)
+		}
+
 		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
 	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
 
 	return nil
 }
 
+// GetAncestorIds returns the category id followed by the ids of its parents up to the top level.
+func (r *categoryRepository) GetAncestorIds(ctx context.Context, id int) ([]int, error) {
+	queryRaw, args, err := sq.
+		Select("id").
+		Prefix(`WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id FROM categories WHERE id = ?
+			UNION
+			SELECT c.id, c.parent_id FROM categories AS c JOIN ancestors AS a ON c.id = a.parent_id
+		)`, id).
+		PlaceholderFormat(sq.Dollar).
+		From("ancestors").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "categoryRepository.GetAncestorIds",
+		QueryRaw: queryRaw,
+	}
+
+	var ids []int
+	if err = r.dbc.DB().ScanAllContext(ctx, &ids, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return ids, nil
+}
+
+// LockAncestorIds locks the categories with the given ids and all their ancestors until the transaction ends
+// and returns the locked ids. Rows are locked in id order so concurrent moves wait for each other instead of deadlocking.
+func (r *categoryRepository) LockAncestorIds(ctx context.Context, ids []int) ([]int, error) {
+	queryRaw, args, err := sq.
+		Select("id").
+		Prefix(`WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id FROM categories WHERE id = ANY(?)
+			UNION
+			SELECT c.id, c.parent_id FROM categories AS c JOIN ancestors AS a ON c.id = a.parent_id
+		)`, ids).
+		PlaceholderFormat(sq.Dollar).
+		From("categories").
+		Where("id IN (SELECT id FROM ancestors)").
+		OrderBy("id").
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "categoryRepository.LockAncestorIds",
+		QueryRaw: queryRaw,
+	}
+
+	var locked []int
+	if err = r.dbc.DB().ScanAllContext(ctx, &locked, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return locked, nil
+}
+
+// Delete moves the category to the trash, relations are kept so a restore brings them back.
 func (r *categoryRepository) Delete(ctx context.Context, id int) error {
+	queryRaw, args, err := sq.
+		Update("categories").
+		PlaceholderFormat(sq.Dollar).
+		Set("deleted_at", time.Now()).
+		Where(sq.Eq{"id": id, "deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "categoryRepository.Delete",
+		QueryRaw: queryRaw,
+	}
+
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *categoryRepository) GetDeleted(ctx context.Context) ([]categoryRepoModel.Category, error) {
+	queryRaw, args, err := sq.
+		Select(
+			"id",
+			"name",
+			"description",
+			iconColumn,
+			"icon_id",
+			"author",
+			"status",
+			"parent_id",
+			"position",
+			"rejection_reason",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+		).
+		PlaceholderFormat(sq.Dollar).
+		From("categories").
+		Where(sq.NotEq{"deleted_at": nil}).
+		OrderBy("deleted_at DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "categoryRepository.GetDeleted",
+		QueryRaw: queryRaw,
+	}
+
+	var categories []categoryRepoModel.Category
+	if err = r.dbc.DB().ScanAllContext(ctx, &categories, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return categories, nil
+}
+
+func (r *categoryRepository) Restore(ctx context.Context, id int) error {
+	queryRaw, args, err := sq.
+		Update("categories").
+		PlaceholderFormat(sq.Dollar).
+		Set("deleted_at", nil).
+		Where(sq.Eq{"id": id}).
+		Where(sq.NotEq{"deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "categoryRepository.Restore",
+		QueryRaw: queryRaw,
+	}
+
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// HardDelete removes a trashed category for good, FK cascades drop its relations.
+func (r *categoryRepository) HardDelete(ctx context.Context, id int) error {
 	queryRaw, args, err := sq.
 		Delete("categories").
 		PlaceholderFormat(sq.Dollar).
 		Where(sq.Eq{"id": id}).
+		Where(sq.NotEq{"deleted_at": nil}).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
 	}
 
 	query := db.Query{
-		Name:     "categoryRepository.Delete",
+		Name:     "categoryRepository.HardDelete",
 		QueryRaw: queryRaw,
 	}
 
-	if _, err = r.dbc.DB().ExecContext(ctx, query, args...); err != nil {
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
 		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
 	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
 
 	return nil
 }
+
+// Purge removes trashed categories for good, FK cascades drop their relations.
+func (r *categoryRepository) Purge(ctx context.Context, deletedBefore time.Time) ([]int, error) {
+	queryRaw, args, err := sq.
+		Delete("categories").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Lt{"deleted_at": deletedBefore}).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "categoryRepository.Purge",
+		QueryRaw: queryRaw,
+	}
+
+	var ids []int
+	if err = r.dbc.DB().ScanAllContext(ctx, &ids, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return ids, nil
+}
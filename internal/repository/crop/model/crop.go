@@ -5,21 +5,36 @@ import "time"
 type Crop struct {
 	ID int `db:"id"`
 	CropInfo
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	RejectionReason *string   `db:"rejection_reason"`
+	CreatedAt       time.Time `db:"created_at"`
+	UpdatedAt       time.Time `db:"updated_at"`
+
+	// DeletedAt is only selected when listing the trash.
+	DeletedAt *time.Time `db:"deleted_at"`
 }
 
 type CropInfo struct {
 	Name        string  `db:"name"`
 	Description *string `db:"description"`
 	Img         *string `db:"img"`
+	ImgId       *int    `db:"img_id"`
 	Status      int     `db:"status"`
 	Author      *int    `db:"author"`
+	ParentId    *int    `db:"parent_id"`
+	Family      *string `db:"family"`
+	Genus       *string `db:"genus"`
+	Species     *string `db:"species"`
 }
 
 type UpdateInput struct {
 	Name        *string `db:"name"`
 	Description *string `db:"description"`
-	Img         *string `db:"img"`
+	ImgId       *int    `db:"img_id"`
 	Status      *int    `db:"status"`
+	Family      *string `db:"family"`
+	Genus       *string `db:"genus"`
+	Species     *string `db:"species"`
+
+	// RejectionReason set to an empty string clears the stored reason.
+	RejectionReason *string `db:"rejection_reason"`
 }
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/nogavadu/articles-service/internal/lib/postgresErrors"
+	"github.com/nogavadu/articles-service/internal/lib/sqlutil"
 	"github.com/nogavadu/articles-service/internal/repository"
 	cropRepoModel "github.com/nogavadu/articles-service/internal/repository/crop/model"
 	"github.com/nogavadu/platform_common/pkg/db"
@@ -20,6 +22,9 @@ var (
 	ErrInternalServerError = errors.New("internal server error")
 )
 
+// imgColumn resolves the uploaded image to its url, crops created before uploads existed keep their url.
+const imgColumn = "COALESCE((SELECT url FROM media WHERE media.id = crops.img_id), crops.img) AS img"
+
 type cropRepository struct {
 	dbc db.Client
 }
@@ -37,18 +42,26 @@ func (r *cropRepository) Create(ctx context.Context, cropInfo *cropRepoModel.Cro
 		Columns(
 			"name",
 			"description",
-			"img",
+			"img_id",
 			"author",
 			"status",
+			"parent_id",
+			"family",
+			"genus",
+			"species",
 			"created_at",
 			"updated_at",
 		).
 		Values(
 			cropInfo.Name,
 			cropInfo.Description,
-			cropInfo.Img,
+			cropInfo.ImgId,
 			cropInfo.Author,
 			cropInfo.Status,
+			cropInfo.ParentId,
+			cropInfo.Family,
+			cropInfo.Genus,
+			cropInfo.Species,
 			time.Now(),
 			time.Now(),
 		).
@@ -70,6 +83,9 @@ func (r *cropRepository) Create(ctx context.Context, cropInfo *cropRepoModel.Cro
 			if pgErr.Code == postgresErrors.AlreadyExistsErrCode {
 				return 0, fmt.Errorf("%w: %w", ErrAlreadyExists, err)
 			}
+			if pgErr.Code == postgresErrors.InvalidForeignKeyErrCode {
+				return 0, fmt.Errorf("%w: %w", ErrInvalidArguments, err)
+			}
 		}
 
 		return 0, fmt.Errorf("%w: %w", ErrInternalServerError, err)
@@ -84,15 +100,21 @@ func (r *cropRepository) GetAll(ctx context.Context, statusId int) ([]cropRepoMo
 			"id",
 			"name",
 			"description",
-			"img",
+			imgColumn,
+			"img_id",
 			"author",
 			"status",
+			"parent_id",
+			"family",
+			"genus",
+			"species",
+			"rejection_reason",
 			"created_at",
 			"updated_at",
 		).
 		PlaceholderFormat(sq.Dollar).
 		From("crops").
-		Where(sq.Eq{"status": statusId}).
+		Where(sq.Eq{"status": statusId, "deleted_at": nil}).
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
@@ -117,15 +139,21 @@ func (r *cropRepository) GetById(ctx context.Context, id int) (*cropRepoModel.Cr
 			"id",
 			"name",
 			"description",
-			"img",
+			imgColumn,
+			"img_id",
 			"author",
 			"status",
+			"parent_id",
+			"family",
+			"genus",
+			"species",
+			"rejection_reason",
 			"created_at",
 			"updated_at",
 		).
 		PlaceholderFormat(sq.Dollar).
 		From("crops").
-		Where(sq.Eq{"id": id}).
+		Where(sq.Eq{"id": id, "deleted_at": nil}).
 		Limit(1).
 		ToSql()
 	if err != nil {
@@ -139,6 +167,9 @@ func (r *cropRepository) GetById(ctx context.Context, id int) (*cropRepoModel.Cr
 
 	var crop cropRepoModel.Crop
 	if err = r.dbc.DB().ScanOneContext(ctx, &crop, query, args...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
 		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
 	}
 
@@ -156,18 +187,32 @@ func (r *cropRepository) Update(ctx context.Context, id int, input *cropRepoMode
 	if input.Description != nil {
 		values["description"] = *input.Description
 	}
-	if input.Img != nil {
-		values["img"] = *input.Img
+	if input.ImgId != nil {
+		// The legacy url is dropped as well, otherwise it would show up again once the image is removed.
+		values["img_id"] = sqlutil.NullIfZero(*input.ImgId)
+		values["img"] = nil
 	}
 	if input.Status != nil {
 		values["status"] = *input.Status
 	}
+	if input.Family != nil {
+		values["family"] = sqlutil.NullIfEmpty(*input.Family)
+	}
+	if input.Genus != nil {
+		values["genus"] = sqlutil.NullIfEmpty(*input.Genus)
+	}
+	if input.Species != nil {
+		values["species"] = sqlutil.NullIfEmpty(*input.Species)
+	}
+	if input.RejectionReason != nil {
+		values["rejection_reason"] = sqlutil.NullIfEmpty(*input.RejectionReason)
+	}
 
 	queryRaw, args, err := sq.
 		Update("crops").
 		PlaceholderFormat(sq.Dollar).
 		SetMap(values).
-		Where(sq.Eq{"id": id}).
+		Where(sq.Eq{"id": id, "deleted_at": nil}).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
@@ -178,31 +223,266 @@ func (r *cropRepository) Update(ctx context.Context, id int, input *cropRepoMode
 		QueryRaw: queryRaw,
 	}
 
-	if _, err = r.dbc.DB().ExecContext(ctx, query, args...); err != nil {
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == postgresErrors.InvalidForeignKeyErrCode {
+			return fmt.Errorf("%w: %w", ErrInvalidArguments, err)
+		}
+
 		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
 	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
 
 	return nil
 }
 
+// SetParent makes the crop a variety of parentId, or a standalone crop when parentId is nil.
+func (r *cropRepository) SetParent(ctx context.Context, id int, parentId *int) error {
+	queryRaw, args, err := sq.
+		Update("crops").
+		PlaceholderFormat(sq.Dollar).
+		SetMap(map[string]interface{}{
+			"parent_id":  parentId,
+			"updated_at": time.Now(),
+		}).
+		Where(sq.Eq{"id": id, "deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "cropRepository.SetParent",
+		QueryRaw: queryRaw,
+	}
+
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == postgresErrors.InvalidForeignKeyErrCode {
+			return fmt.Errorf("%w: %w", ErrInvalidArguments, err)
+		}
+
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// GetAncestorIds returns the crop id followed by the ids of the crops it is a variety of.
+func (r *cropRepository) GetAncestorIds(ctx context.Context, id int) ([]int, error) {
+	queryRaw, args, err := sq.
+		Select("id").
+		Prefix(`WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id FROM crops WHERE id = ?
+			UNION
+			SELECT c.id, c.parent_id FROM crops AS c JOIN ancestors AS a ON c.id = a.parent_id
+		)`, id).
+		PlaceholderFormat(sq.Dollar).
+		From("ancestors").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "cropRepository.GetAncestorIds",
+		QueryRaw: queryRaw,
+	}
+
+	var ids []int
+	if err = r.dbc.DB().ScanAllContext(ctx, &ids, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return ids, nil
+}
+
+// LockAncestorIds locks the crops with the given ids and all their ancestors until the transaction ends
+// and returns the locked ids. Rows are locked in id order so concurrent moves wait for each other instead of deadlocking.
+func (r *cropRepository) LockAncestorIds(ctx context.Context, ids []int) ([]int, error) {
+	queryRaw, args, err := sq.
+		Select("id").
+		Prefix(`WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id FROM crops WHERE id = ANY(?)
+			UNION
+			SELECT c.id, c.parent_id FROM crops AS c JOIN ancestors AS a ON c.id = a.parent_id
+		)`, ids).
+		PlaceholderFormat(sq.Dollar).
+		From("crops").
+		Where("id IN (SELECT id FROM ancestors)").
+		OrderBy("id").
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "cropRepository.LockAncestorIds",
+		QueryRaw: queryRaw,
+	}
+
+	var locked []int
+	if err = r.dbc.DB().ScanAllContext(ctx, &locked, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return locked, nil
+}
+
+// Delete moves the crop to the trash, relations are kept so a restore brings them back.
 func (r *cropRepository) Delete(ctx context.Context, id int) error {
+	queryRaw, args, err := sq.
+		Update("crops").
+		PlaceholderFormat(sq.Dollar).
+		Set("deleted_at", time.Now()).
+		Where(sq.Eq{"id": id, "deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "cropRepository.Delete",
+		QueryRaw: queryRaw,
+	}
+
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *cropRepository) GetDeleted(ctx context.Context) ([]cropRepoModel.Crop, error) {
+	queryRaw, args, err := sq.
+		Select(
+			"id",
+			"name",
+			"description",
+			imgColumn,
+			"img_id",
+			"author",
+			"status",
+			"parent_id",
+			"family",
+			"genus",
+			"species",
+			"rejection_reason",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+		).
+		PlaceholderFormat(sq.Dollar).
+		From("crops").
+		Where(sq.NotEq{"deleted_at": nil}).
+		OrderBy("deleted_at DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "cropRepository.GetDeleted",
+		QueryRaw: queryRaw,
+	}
+
+	var crops []cropRepoModel.Crop
+	if err = r.dbc.DB().ScanAllContext(ctx, &crops, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return crops, nil
+}
+
+func (r *cropRepository) Restore(ctx context.Context, id int) error {
+	queryRaw, args, err := sq.
+		Update("crops").
+		PlaceholderFormat(sq.Dollar).
+		Set("deleted_at", nil).
+		Where(sq.Eq{"id": id}).
+		Where(sq.NotEq{"deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "cropRepository.Restore",
+		QueryRaw: queryRaw,
+	}
+
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// HardDelete removes a trashed crop for good, FK cascades drop its relations.
+func (r *cropRepository) HardDelete(ctx context.Context, id int) error {
 	queryRaw, args, err := sq.
 		Delete("crops").
 		PlaceholderFormat(sq.Dollar).
 		Where(sq.Eq{"id": id}).
+		Where(sq.NotEq{"deleted_at": nil}).
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
 	}
 
 	query := db.Query{
-		Name:     "cropRepository.Delete",
+		Name:     "cropRepository.HardDelete",
 		QueryRaw: queryRaw,
 	}
 
-	if _, err = r.dbc.DB().ExecContext(ctx, query, args...); err != nil {
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
 		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
 	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
 
 	return nil
 }
+
+// Purge removes trashed crops for good, FK cascades drop their relations.
+func (r *cropRepository) Purge(ctx context.Context, deletedBefore time.Time) ([]int, error) {
+	queryRaw, args, err := sq.
+		Delete("crops").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Lt{"deleted_at": deletedBefore}).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "cropRepository.Purge",
+		QueryRaw: queryRaw,
+	}
+
+	var ids []int
+	if err = r.dbc.DB().ScanAllContext(ctx, &ids, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,44 @@
+package model
+
+import "time"
+
+type Entry struct {
+	Id     int `db:"id"`
+	CropId int `db:"crop_id"`
+	EntryInfo
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+type EntryInfo struct {
+	Activity   string  `db:"activity"`
+	Zone       string  `db:"zone"`
+	StartMonth int     `db:"start_month"`
+	EndMonth   int     `db:"end_month"`
+	StartWeek  *int    `db:"start_week"`
+	EndWeek    *int    `db:"end_week"`
+	Notes      *string `db:"notes"`
+}
+
+type UpdateInput struct {
+	Activity   *string `db:"activity"`
+	Zone       *string `db:"zone"`
+	StartMonth *int    `db:"start_month"`
+	EndMonth   *int    `db:"end_month"`
+	StartWeek  *int    `db:"start_week"`
+	EndWeek    *int    `db:"end_week"`
+	Notes      *string `db:"notes"`
+}
+
+type ZoneParams struct {
+	Zone  string
+	Month int
+	Week  *int
+	// CropStatus limits the entries to crops with this status.
+	CropStatus int
+}
+
+type ZoneEntry struct {
+	Entry
+	CropName string `db:"crop_name"`
+}
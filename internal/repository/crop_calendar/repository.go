@@ -0,0 +1,308 @@
+package crop_calendar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/nogavadu/articles-service/internal/lib/postgresErrors"
+	"github.com/nogavadu/articles-service/internal/lib/sqlutil"
+	"github.com/nogavadu/articles-service/internal/repository"
+	calendarRepoModel "github.com/nogavadu/articles-service/internal/repository/crop_calendar/model"
+	"github.com/nogavadu/platform_common/pkg/db"
+	"time"
+)
+
+var (
+	ErrNotFound            = errors.New("calendar entry not found")
+	ErrInvalidArguments    = errors.New("invalid arguments")
+	ErrInternalServerError = errors.New("internal server error")
+)
+
+var entryColumns = []string{
+	"id",
+	"crop_id",
+	"activity",
+	"zone",
+	"start_month",
+	"end_month",
+	"start_week",
+	"end_week",
+	"notes",
+	"created_at",
+	"updated_at",
+}
+
+type cropCalendarRepository struct {
+	dbc db.Client
+}
+
+func New(dbc db.Client) repository.CropCalendarRepository {
+	return &cropCalendarRepository{
+		dbc: dbc,
+	}
+}
+
+func (r *cropCalendarRepository) Create(ctx context.Context, cropId int, info *calendarRepoModel.EntryInfo) (int, error) {
+	queryRaw, args, err := sq.
+		Insert("crop_calendar").
+		PlaceholderFormat(sq.Dollar).
+		Columns(
+			"crop_id",
+			"activity",
+			"zone",
+			"start_month",
+			"end_month",
+			"start_week",
+			"end_week",
+			"notes",
+			"created_at",
+			"updated_at",
+		).
+		Values(
+			cropId,
+			info.Activity,
+			info.Zone,
+			info.StartMonth,
+			info.EndMonth,
+			info.StartWeek,
+			info.EndWeek,
+			info.Notes,
+			time.Now(),
+			time.Now(),
+		).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "cropCalendarRepository.Create",
+		QueryRaw: queryRaw,
+	}
+
+	var id int
+	if err = r.dbc.DB().ScanOneContext(ctx, &id, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == postgresErrors.InvalidForeignKeyErrCode {
+			return 0, fmt.Errorf("%w: %w", ErrInvalidArguments, err)
+		}
+
+		return 0, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return id, nil
+}
+
+func (r *cropCalendarRepository) GetAll(ctx context.Context, cropId int) ([]calendarRepoModel.Entry, error) {
+	queryRaw, args, err := sq.
+		Select(entryColumns...).
+		PlaceholderFormat(sq.Dollar).
+		From("crop_calendar").
+		Where(sq.Eq{"crop_id": cropId}).
+		OrderBy("zone", "start_month", "id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "cropCalendarRepository.GetAll",
+		QueryRaw: queryRaw,
+	}
+
+	var entries []calendarRepoModel.Entry
+	if err = r.dbc.DB().ScanAllContext(ctx, &entries, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return entries, nil
+}
+
+func (r *cropCalendarRepository) GetById(ctx context.Context, cropId int, id int) (*calendarRepoModel.Entry, error) {
+	queryRaw, args, err := sq.
+		Select(entryColumns...).
+		PlaceholderFormat(sq.Dollar).
+		From("crop_calendar").
+		Where(sq.Eq{"id": id, "crop_id": cropId}).
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "cropCalendarRepository.GetById",
+		QueryRaw: queryRaw,
+	}
+
+	var entry calendarRepoModel.Entry
+	if err = r.dbc.DB().ScanOneContext(ctx, &entry, query, args...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return &entry, nil
+}
+
+// GetByZone returns the entries of the zone whose period covers the month and, for entries with weeks, the week.
+// A period ending before it starts wraps around the new year, e.g. November to February.
+func (r *cropCalendarRepository) GetByZone(
+	ctx context.Context,
+	params *calendarRepoModel.ZoneParams,
+) ([]calendarRepoModel.ZoneEntry, error) {
+	builder := sq.
+		Select(
+			"cc.id",
+			"cc.crop_id",
+			"cc.activity",
+			"cc.zone",
+			"cc.start_month",
+			"cc.end_month",
+			"cc.start_week",
+			"cc.end_week",
+			"cc.notes",
+			"cc.created_at",
+			"cc.updated_at",
+			"c.name AS crop_name",
+		).
+		PlaceholderFormat(sq.Dollar).
+		From("crop_calendar AS cc").
+		Join("crops AS c ON c.id = cc.crop_id").
+		Where(sq.Eq{"cc.zone": params.Zone, "c.status": params.CropStatus, "c.deleted_at": nil}).
+		Where(periodCovers("cc.start_month", "cc.end_month", params.Month)).
+		OrderBy("cc.activity", "c.name", "cc.id")
+
+	if params.Week != nil {
+		builder = builder.Where(sq.Or{
+			sq.Eq{"cc.start_week": nil},
+			periodCovers("cc.start_week", "cc.end_week", *params.Week),
+		})
+	}
+
+	queryRaw, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "cropCalendarRepository.GetByZone",
+		QueryRaw: queryRaw,
+	}
+
+	var entries []calendarRepoModel.ZoneEntry
+	if err = r.dbc.DB().ScanAllContext(ctx, &entries, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return entries, nil
+}
+
+func (r *cropCalendarRepository) Update(
+	ctx context.Context,
+	cropId int,
+	id int,
+	input *calendarRepoModel.UpdateInput,
+) error {
+	values := map[string]interface{}{
+		"updated_at": time.Now(),
+	}
+
+	if input.Activity != nil {
+		values["activity"] = *input.Activity
+	}
+	if input.Zone != nil {
+		values["zone"] = *input.Zone
+	}
+	if input.StartMonth != nil {
+		values["start_month"] = *input.StartMonth
+	}
+	if input.EndMonth != nil {
+		values["end_month"] = *input.EndMonth
+	}
+	if input.StartWeek != nil {
+		values["start_week"] = sqlutil.NullIfZero(*input.StartWeek)
+	}
+	if input.EndWeek != nil {
+		values["end_week"] = sqlutil.NullIfZero(*input.EndWeek)
+	}
+	if input.Notes != nil {
+		values["notes"] = sqlutil.NullIfEmpty(*input.Notes)
+	}
+
+	queryRaw, args, err := sq.
+		Update("crop_calendar").
+		PlaceholderFormat(sq.Dollar).
+		SetMap(values).
+		Where(sq.Eq{"id": id, "crop_id": cropId}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "cropCalendarRepository.Update",
+		QueryRaw: queryRaw,
+	}
+
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *cropCalendarRepository) Delete(ctx context.Context, cropId int, id int) error {
+	queryRaw, args, err := sq.
+		Delete("crop_calendar").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"id": id, "crop_id": cropId}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "cropCalendarRepository.Delete",
+		QueryRaw: queryRaw,
+	}
+
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// periodCovers matches rows whose period from the start to the end column covers value,
+// a period ending before it starts wraps around, e.g. November to February.
+func periodCovers(start string, end string, value int) sq.Sqlizer {
+	return sq.Or{
+		sq.And{
+			sq.Expr(start + " <= " + end),
+			sq.LtOrEq{start: value},
+			sq.GtOrEq{end: value},
+		},
+		sq.And{
+			sq.Expr(start + " > " + end),
+			sq.Or{
+				sq.LtOrEq{start: value},
+				sq.GtOrEq{end: value},
+			},
+		},
+	}
+}
@@ -5,13 +5,16 @@ import (
 	"errors"
 	"fmt"
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/nogavadu/articles-service/internal/lib/postgresErrors"
 	"github.com/nogavadu/articles-service/internal/repository"
 	"github.com/nogavadu/platform_common/pkg/db"
 )
 
 var (
-	ErrAlreadyExists       = errors.New("crop already exists")
-	ErrNotFound            = errors.New("crop not found")
+	ErrAlreadyExists       = errors.New("crop category relation already exists")
+	ErrNotFound            = errors.New("crop category relation not found")
+	ErrInUse               = errors.New("crop category relation is used by articles")
 	ErrInvalidArguments    = errors.New("invalid arguments")
 	ErrInternalServerError = errors.New("internal server error")
 )
@@ -43,6 +46,16 @@ func (r *cropCategoriesRepository) Create(ctx context.Context, cropId int, categ
 	}
 
 	if _, err = r.dbc.DB().ExecContext(ctx, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == postgresErrors.AlreadyExistsErrCode {
+				return fmt.Errorf("%w: %w", ErrAlreadyExists, err)
+			}
+			if pgErr.Code == postgresErrors.InvalidForeignKeyErrCode {
+				return fmt.Errorf("%w: %w", ErrNotFound, err)
+			}
+		}
+
 		return fmt.Errorf("%s: %w", ErrInternalServerError, err)
 	}
 
@@ -67,9 +80,18 @@ func (r *cropCategoriesRepository) Delete(ctx context.Context, cropId int, categ
 		QueryRaw: queryRaw,
 	}
 
-	if _, err = r.dbc.DB().ExecContext(ctx, query, args...); err != nil {
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == postgresErrors.InvalidForeignKeyErrCode {
+			return fmt.Errorf("%w: %w", ErrInUse, err)
+		}
+
 		return fmt.Errorf("%s: %w", ErrInternalServerError, err)
 	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
 
 	return nil
 }
@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+type CompanionInfo struct {
+	Type string  `db:"type"`
+	Note *string `db:"note"`
+}
+
+// Companion is a relation seen from one of its crops.
+type Companion struct {
+	CompanionId   int    `db:"companion_id"`
+	CompanionName string `db:"companion_name"`
+	CompanionInfo
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+type Conflict struct {
+	CropId        int     `db:"crop_id"`
+	CropName      string  `db:"crop_name"`
+	CompanionId   int     `db:"companion_id"`
+	CompanionName string  `db:"companion_name"`
+	Note          *string `db:"note"`
+}
@@ -0,0 +1,212 @@
+package crop_companions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/nogavadu/articles-service/internal/lib/postgresErrors"
+	"github.com/nogavadu/articles-service/internal/repository"
+	companionRepoModel "github.com/nogavadu/articles-service/internal/repository/crop_companions/model"
+	"github.com/nogavadu/platform_common/pkg/db"
+	"time"
+)
+
+var (
+	ErrNotFound            = errors.New("crop companion not found")
+	ErrInvalidArguments    = errors.New("invalid arguments")
+	ErrInternalServerError = errors.New("internal server error")
+)
+
+const typeAntagonist = "antagonist"
+
+type cropCompanionsRepository struct {
+	dbc db.Client
+}
+
+func New(dbc db.Client) repository.CropCompanionsRepository {
+	return &cropCompanionsRepository{
+		dbc: dbc,
+	}
+}
+
+func (r *cropCompanionsRepository) GetAll(ctx context.Context, cropId int) ([]companionRepoModel.Companion, error) {
+	const other = "CASE WHEN cc.crop_id = ? THEN cc.companion_id ELSE cc.crop_id END"
+
+	queryRaw, args, err := sq.
+		Select(
+			"c.id AS companion_id",
+			"c.name AS companion_name",
+			"cc.type",
+			"cc.note",
+			"cc.created_at",
+			"cc.updated_at",
+		).
+		PlaceholderFormat(sq.Dollar).
+		From("crop_companions AS cc").
+		Join("crops AS c ON c.id = "+other, cropId).
+		Where(sq.Or{sq.Eq{"cc.crop_id": cropId}, sq.Eq{"cc.companion_id": cropId}}).
+		Where(sq.Eq{"c.deleted_at": nil}).
+		OrderBy("c.name").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "cropCompanionsRepository.GetAll",
+		QueryRaw: queryRaw,
+	}
+
+	var companions []companionRepoModel.Companion
+	if err = r.dbc.DB().ScanAllContext(ctx, &companions, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return companions, nil
+}
+
+func (r *cropCompanionsRepository) Get(ctx context.Context, cropId int, companionId int) (*companionRepoModel.CompanionInfo, error) {
+	low, high := orderPair(cropId, companionId)
+
+	queryRaw, args, err := sq.
+		Select("type", "note").
+		PlaceholderFormat(sq.Dollar).
+		From("crop_companions").
+		Where(sq.Eq{"crop_id": low, "companion_id": high}).
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "cropCompanionsRepository.Get",
+		QueryRaw: queryRaw,
+	}
+
+	var info companionRepoModel.CompanionInfo
+	if err = r.dbc.DB().ScanOneContext(ctx, &info, query, args...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return &info, nil
+}
+
+// Set creates the relation between the two crops or replaces its type and note.
+func (r *cropCompanionsRepository) Set(
+	ctx context.Context,
+	cropId int,
+	companionId int,
+	info *companionRepoModel.CompanionInfo,
+) error {
+	low, high := orderPair(cropId, companionId)
+
+	queryRaw, args, err := sq.
+		Insert("crop_companions").
+		PlaceholderFormat(sq.Dollar).
+		Columns("crop_id", "companion_id", "type", "note", "created_at", "updated_at").
+		Values(low, high, info.Type, info.Note, time.Now(), time.Now()).
+		Suffix("ON CONFLICT (crop_id, companion_id) DO UPDATE SET type = EXCLUDED.type, note = EXCLUDED.note, updated_at = EXCLUDED.updated_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "cropCompanionsRepository.Set",
+		QueryRaw: queryRaw,
+	}
+
+	if _, err = r.dbc.DB().ExecContext(ctx, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == postgresErrors.InvalidForeignKeyErrCode {
+			return fmt.Errorf("%w: %w", ErrInvalidArguments, err)
+		}
+
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return nil
+}
+
+func (r *cropCompanionsRepository) Delete(ctx context.Context, cropId int, companionId int) error {
+	low, high := orderPair(cropId, companionId)
+
+	queryRaw, args, err := sq.
+		Delete("crop_companions").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"crop_id": low, "companion_id": high}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "cropCompanionsRepository.Delete",
+		QueryRaw: queryRaw,
+	}
+
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// GetConflicts returns the antagonist pairs among the crops.
+func (r *cropCompanionsRepository) GetConflicts(ctx context.Context, cropIds []int) ([]companionRepoModel.Conflict, error) {
+	queryRaw, args, err := sq.
+		Select(
+			"cc.crop_id",
+			"a.name AS crop_name",
+			"cc.companion_id",
+			"b.name AS companion_name",
+			"cc.note",
+		).
+		PlaceholderFormat(sq.Dollar).
+		From("crop_companions AS cc").
+		Join("crops AS a ON a.id = cc.crop_id").
+		Join("crops AS b ON b.id = cc.companion_id").
+		Where(sq.Eq{
+			"cc.type":         typeAntagonist,
+			"cc.crop_id":      cropIds,
+			"cc.companion_id": cropIds,
+			"a.deleted_at":    nil,
+			"b.deleted_at":    nil,
+		}).
+		OrderBy("cc.crop_id", "cc.companion_id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "cropCompanionsRepository.GetConflicts",
+		QueryRaw: queryRaw,
+	}
+
+	var conflicts []companionRepoModel.Conflict
+	if err = r.dbc.DB().ScanAllContext(ctx, &conflicts, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return conflicts, nil
+}
+
+func orderPair(a int, b int) (int, int) {
+	if a > b {
+		return b, a
+	}
+	return a, b
+}
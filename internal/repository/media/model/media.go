@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+type Media struct {
+	Id int `db:"id"`
+	MediaInfo
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type MediaInfo struct {
+	Key         string `db:"key"`
+	Url         string `db:"url"`
+	Checksum    string `db:"checksum"`
+	ContentType string `db:"content_type"`
+	Size        int64  `db:"size"`
+	Width       *int   `db:"width"`
+	Height      *int   `db:"height"`
+	Author      *int   `db:"author"`
+}
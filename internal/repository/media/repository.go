@@ -0,0 +1,114 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/nogavadu/articles-service/internal/lib/postgresErrors"
+	"github.com/nogavadu/articles-service/internal/repository"
+	mediaRepoModel "github.com/nogavadu/articles-service/internal/repository/media/model"
+	"github.com/nogavadu/platform_common/pkg/db"
+	"time"
+)
+
+var (
+	ErrAlreadyExists       = errors.New("media already exists")
+	ErrNotFound            = errors.New("media not found")
+	ErrInternalServerError = errors.New("internal server error")
+)
+
+var mediaColumns = []string{
+	"id",
+	"key",
+	"url",
+	"checksum",
+	"content_type",
+	"size",
+	"width",
+	"height",
+	"author",
+	"created_at",
+}
+
+type mediaRepository struct {
+	dbc db.Client
+}
+
+func New(dbc db.Client) repository.MediaRepository {
+	return &mediaRepository{
+		dbc: dbc,
+	}
+}
+
+func (r *mediaRepository) Create(ctx context.Context, info *mediaRepoModel.MediaInfo) (int, error) {
+	queryRaw, args, err := sq.
+		Insert("media").
+		PlaceholderFormat(sq.Dollar).
+		Columns("key", "url", "checksum", "content_type", "size", "width", "height", "author", "created_at").
+		Values(info.Key, info.Url, info.Checksum, info.ContentType, info.Size, info.Width, info.Height, info.Author, time.Now()).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "mediaRepository.Create",
+		QueryRaw: queryRaw,
+	}
+
+	var id int
+	if err = r.dbc.DB().ScanOneContext(ctx, &id, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == postgresErrors.AlreadyExistsErrCode {
+			return 0, fmt.Errorf("%w: %w", ErrAlreadyExists, err)
+		}
+
+		return 0, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return id, nil
+}
+
+func (r *mediaRepository) GetById(ctx context.Context, id int) (*mediaRepoModel.Media, error) {
+	return r.getOne(ctx, "mediaRepository.GetById", sq.Eq{"id": id})
+}
+
+func (r *mediaRepository) GetByKey(ctx context.Context, key string) (*mediaRepoModel.Media, error) {
+	return r.getOne(ctx, "mediaRepository.GetByKey", sq.Eq{"key": key})
+}
+
+func (r *mediaRepository) GetByChecksum(ctx context.Context, checksum string) (*mediaRepoModel.Media, error) {
+	return r.getOne(ctx, "mediaRepository.GetByChecksum", sq.Eq{"checksum": checksum})
+}
+
+func (r *mediaRepository) getOne(ctx context.Context, name string, where sq.Eq) (*mediaRepoModel.Media, error) {
+	queryRaw, args, err := sq.
+		Select(mediaColumns...).
+		PlaceholderFormat(sq.Dollar).
+		From("media").
+		Where(where).
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     name,
+		QueryRaw: queryRaw,
+	}
+
+	var media mediaRepoModel.Media
+	if err = r.dbc.DB().ScanOneContext(ctx, &media, query, args...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return &media, nil
+}
@@ -0,0 +1,16 @@
+package model
+
+type Variant struct {
+	MediaId int `db:"media_id"`
+	VariantInfo
+}
+
+type VariantInfo struct {
+	Name        string `db:"name"`
+	Key         string `db:"key"`
+	Url         string `db:"url"`
+	ContentType string `db:"content_type"`
+	Size        int64  `db:"size"`
+	Width       int    `db:"width"`
+	Height      int    `db:"height"`
+}
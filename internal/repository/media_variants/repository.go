@@ -0,0 +1,131 @@
+package media_variants
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/nogavadu/articles-service/internal/repository"
+	variantRepoModel "github.com/nogavadu/articles-service/internal/repository/media_variants/model"
+	"github.com/nogavadu/platform_common/pkg/db"
+)
+
+var (
+	ErrNotFound            = errors.New("media variant not found")
+	ErrInternalServerError = errors.New("internal server error")
+)
+
+var variantColumns = []string{
+	"media_id",
+	"name",
+	"key",
+	"url",
+	"content_type",
+	"size",
+	"width",
+	"height",
+}
+
+type mediaVariantsRepository struct {
+	dbc db.Client
+}
+
+func New(dbc db.Client) repository.MediaVariantsRepository {
+	return &mediaVariantsRepository{
+		dbc: dbc,
+	}
+}
+
+func (r *mediaVariantsRepository) CreateBulk(ctx context.Context, mediaId int, variants []variantRepoModel.VariantInfo) error {
+	if len(variants) == 0 {
+		return nil
+	}
+
+	builder := sq.
+		Insert("media_variants").
+		PlaceholderFormat(sq.Dollar).
+		Columns(variantColumns...)
+
+	for _, v := range variants {
+		builder = builder.Values(mediaId, v.Name, v.Key, v.Url, v.ContentType, v.Size, v.Width, v.Height)
+	}
+
+	queryRaw, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "mediaVariantsRepository.CreateBulk",
+		QueryRaw: queryRaw,
+	}
+
+	if _, err = r.dbc.DB().ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return nil
+}
+
+func (r *mediaVariantsRepository) GetAllByMediaIds(ctx context.Context, mediaIds []int) (map[int][]variantRepoModel.Variant, error) {
+	variants := make(map[int][]variantRepoModel.Variant, len(mediaIds))
+	if len(mediaIds) == 0 {
+		return variants, nil
+	}
+
+	queryRaw, args, err := sq.
+		Select(variantColumns...).
+		PlaceholderFormat(sq.Dollar).
+		From("media_variants").
+		Where(sq.Eq{"media_id": mediaIds}).
+		OrderBy("media_id", "width").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "mediaVariantsRepository.GetAllByMediaIds",
+		QueryRaw: queryRaw,
+	}
+
+	var rows []variantRepoModel.Variant
+	if err = r.dbc.DB().ScanAllContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	for _, row := range rows {
+		variants[row.MediaId] = append(variants[row.MediaId], row)
+	}
+
+	return variants, nil
+}
+
+func (r *mediaVariantsRepository) GetByKey(ctx context.Context, key string) (*variantRepoModel.Variant, error) {
+	queryRaw, args, err := sq.
+		Select(variantColumns...).
+		PlaceholderFormat(sq.Dollar).
+		From("media_variants").
+		Where(sq.Eq{"key": key}).
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "mediaVariantsRepository.GetByKey",
+		QueryRaw: queryRaw,
+	}
+
+	var variant variantRepoModel.Variant
+	if err = r.dbc.DB().ScanOneContext(ctx, &variant, query, args...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return &variant, nil
+}
@@ -0,0 +1,41 @@
+package model
+
+import "time"
+
+type PestGetAllParams struct {
+	Status int
+	CropId *int
+}
+
+type Pest struct {
+	ID int `db:"id"`
+	PestInfo
+	RejectionReason *string   `db:"rejection_reason"`
+	CreatedAt       time.Time `db:"created_at"`
+	UpdatedAt       time.Time `db:"updated_at"`
+
+	// DeletedAt is only selected when listing the trash.
+	DeletedAt *time.Time `db:"deleted_at"`
+}
+
+type PestInfo struct {
+	Name        string  `db:"name"`
+	LatinName   *string `db:"latin_name"`
+	Type        string  `db:"type"`
+	Symptoms    *string `db:"symptoms"`
+	Description *string `db:"description"`
+	Status      int     `db:"status"`
+	Author      *int    `db:"author"`
+}
+
+type UpdateInput struct {
+	Name        *string `db:"name"`
+	LatinName   *string `db:"latin_name"`
+	Type        *string `db:"type"`
+	Symptoms    *string `db:"symptoms"`
+	Description *string `db:"description"`
+	Status      *int    `db:"status"`
+
+	// RejectionReason set to an empty string clears the stored reason.
+	RejectionReason *string `db:"rejection_reason"`
+}
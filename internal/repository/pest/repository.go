@@ -0,0 +1,346 @@
+package pest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/nogavadu/articles-service/internal/lib/postgresErrors"
+	"github.com/nogavadu/articles-service/internal/lib/sqlutil"
+	"github.com/nogavadu/articles-service/internal/repository"
+	pestRepoModel "github.com/nogavadu/articles-service/internal/repository/pest/model"
+	"github.com/nogavadu/platform_common/pkg/db"
+	"time"
+)
+
+var (
+	ErrAlreadyExists       = errors.New("pest already exists")
+	ErrNotFound            = errors.New("pest not found")
+	ErrInternalServerError = errors.New("internal server error")
+)
+
+var pestColumns = []string{
+	"id",
+	"name",
+	"latin_name",
+	"type",
+	"symptoms",
+	"description",
+	"author",
+	"status",
+	"rejection_reason",
+	"created_at",
+	"updated_at",
+}
+
+type pestRepository struct {
+	dbc db.Client
+}
+
+func New(dbc db.Client) repository.PestRepository {
+	return &pestRepository{
+		dbc: dbc,
+	}
+}
+
+func (r *pestRepository) Create(ctx context.Context, info *pestRepoModel.PestInfo) (int, error) {
+	queryRaw, args, err := sq.
+		Insert("pests").
+		PlaceholderFormat(sq.Dollar).
+		Columns(
+			"name",
+			"latin_name",
+			"type",
+			"symptoms",
+			"description",
+			"author",
+			"status",
+			"created_at",
+			"updated_at",
+		).
+		Values(
+			info.Name,
+			info.LatinName,
+			info.Type,
+			info.Symptoms,
+			info.Description,
+			info.Author,
+			info.Status,
+			time.Now(),
+			time.Now(),
+		).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "pestRepository.Create",
+		QueryRaw: queryRaw,
+	}
+
+	var id int
+	if err = r.dbc.DB().ScanOneContext(ctx, &id, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == postgresErrors.AlreadyExistsErrCode {
+			return 0, fmt.Errorf("%w: %w", ErrAlreadyExists, err)
+		}
+
+		return 0, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return id, nil
+}
+
+func (r *pestRepository) GetAll(ctx context.Context, params *pestRepoModel.PestGetAllParams) ([]pestRepoModel.Pest, error) {
+	builder := sq.
+		Select(pestColumns...).
+		PlaceholderFormat(sq.Dollar).
+		From("pests").
+		Where(sq.Eq{"status": params.Status, "deleted_at": nil}).
+		OrderBy("name")
+
+	if params.CropId != nil {
+		builder = builder.Where("id IN (SELECT pest_id FROM pests_crops WHERE crop_id = ?)", *params.CropId)
+	}
+
+	queryRaw, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "pestRepository.GetAll",
+		QueryRaw: queryRaw,
+	}
+
+	var pests []pestRepoModel.Pest
+	if err = r.dbc.DB().ScanAllContext(ctx, &pests, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return pests, nil
+}
+
+func (r *pestRepository) GetById(ctx context.Context, id int) (*pestRepoModel.Pest, error) {
+	queryRaw, args, err := sq.
+		Select(pestColumns...).
+		PlaceholderFormat(sq.Dollar).
+		From("pests").
+		Where(sq.Eq{"id": id, "deleted_at": nil}).
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "pestRepository.GetById",
+		QueryRaw: queryRaw,
+	}
+
+	var pest pestRepoModel.Pest
+	if err = r.dbc.DB().ScanOneContext(ctx, &pest, query, args...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return &pest, nil
+}
+
+func (r *pestRepository) Update(ctx context.Context, id int, input *pestRepoModel.UpdateInput) error {
+	values := map[string]interface{}{
+		"updated_at": time.Now(),
+	}
+
+	if input.Name != nil {
+		values["name"] = *input.Name
+	}
+	if input.LatinName != nil {
+		values["latin_name"] = sqlutil.NullIfEmpty(*input.LatinName)
+	}
+	if input.Type != nil {
+		values["type"] = *input.Type
+	}
+	if input.Symptoms != nil {
+		values["symptoms"] = sqlutil.NullIfEmpty(*input.Symptoms)
+	}
+	if input.Description != nil {
+		values["description"] = sqlutil.NullIfEmpty(*input.Description)
+	}
+	if input.Status != nil {
+		values["status"] = *input.Status
+	}
+	if input.RejectionReason != nil {
+		values["rejection_reason"] = sqlutil.NullIfEmpty(*input.RejectionReason)
+	}
+
+	queryRaw, args, err := sq.
+		Update("pests").
+		PlaceholderFormat(sq.Dollar).
+		SetMap(values).
+		Where(sq.Eq{"id": id, "deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "pestRepository.Update",
+		QueryRaw: queryRaw,
+	}
+
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == postgresErrors.AlreadyExistsErrCode {
+			return fmt.Errorf("%w: %w", ErrAlreadyExists, err)
+		}
+
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// Delete moves the pest to the trash, its links are kept so a restore brings them back.
+func (r *pestRepository) Delete(ctx context.Context, id int) error {
+	queryRaw, args, err := sq.
+		Update("pests").
+		PlaceholderFormat(sq.Dollar).
+		Set("deleted_at", time.Now()).
+		Where(sq.Eq{"id": id, "deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "pestRepository.Delete",
+		QueryRaw: queryRaw,
+	}
+
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *pestRepository) GetDeleted(ctx context.Context) ([]pestRepoModel.Pest, error) {
+	queryRaw, args, err := sq.
+		Select(append(pestColumns, "deleted_at")...).
+		PlaceholderFormat(sq.Dollar).
+		From("pests").
+		Where(sq.NotEq{"deleted_at": nil}).
+		OrderBy("deleted_at DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "pestRepository.GetDeleted",
+		QueryRaw: queryRaw,
+	}
+
+	var pests []pestRepoModel.Pest
+	if err = r.dbc.DB().ScanAllContext(ctx, &pests, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return pests, nil
+}
+
+func (r *pestRepository) Restore(ctx context.Context, id int) error {
+	queryRaw, args, err := sq.
+		Update("pests").
+		PlaceholderFormat(sq.Dollar).
+		Set("deleted_at", nil).
+		Where(sq.Eq{"id": id}).
+		Where(sq.NotEq{"deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "pestRepository.Restore",
+		QueryRaw: queryRaw,
+	}
+
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// HardDelete removes a trashed pest for good, FK cascades drop its images and links.
+func (r *pestRepository) HardDelete(ctx context.Context, id int) error {
+	queryRaw, args, err := sq.
+		Delete("pests").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"id": id}).
+		Where(sq.NotEq{"deleted_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "pestRepository.HardDelete",
+		QueryRaw: queryRaw,
+	}
+
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// Purge removes trashed pests for good, FK cascades drop their images and links.
+func (r *pestRepository) Purge(ctx context.Context, deletedBefore time.Time) ([]int, error) {
+	queryRaw, args, err := sq.
+		Delete("pests").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Lt{"deleted_at": deletedBefore}).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "pestRepository.Purge",
+		QueryRaw: queryRaw,
+	}
+
+	var ids []int
+	if err = r.dbc.DB().ScanAllContext(ctx, &ids, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return ids, nil
+}
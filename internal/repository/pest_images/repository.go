@@ -0,0 +1,150 @@
+package pest_images
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/nogavadu/articles-service/internal/lib/postgresErrors"
+	"github.com/nogavadu/articles-service/internal/repository"
+	"github.com/nogavadu/platform_common/pkg/db"
+)
+
+var (
+	ErrInvalidArguments    = errors.New("invalid arguments")
+	ErrInternalServerError = errors.New("internal server error")
+)
+
+// imgColumn resolves the uploaded image to its url, images added before uploads existed keep their url.
+const imgColumn = "COALESCE(m.url, i.img) AS img"
+
+type pestImagesRepository struct {
+	dbc db.Client
+}
+
+func New(dbc db.Client) repository.PestImagesRepository {
+	return &pestImagesRepository{
+		dbc: dbc,
+	}
+}
+
+func (r *pestImagesRepository) CreateBulk(ctx context.Context, pestId int, mediaIds []int) error {
+	builder := sq.
+		Insert("pests_images").
+		PlaceholderFormat(sq.Dollar).
+		Columns("pest_id", "media_id")
+
+	for _, mediaId := range mediaIds {
+		builder = builder.Values(pestId, mediaId)
+	}
+
+	queryRaw, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "pestImagesRepository.CreateBulk",
+		QueryRaw: queryRaw,
+	}
+
+	if _, err = r.dbc.DB().ExecContext(ctx, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == postgresErrors.InvalidForeignKeyErrCode {
+			return fmt.Errorf("%w: %w", ErrInvalidArguments, err)
+		}
+
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return nil
+}
+
+func (r *pestImagesRepository) GetAll(ctx context.Context, pestId int) ([]string, error) {
+	queryRaw, args, err := sq.
+		Select(imgColumn).
+		PlaceholderFormat(sq.Dollar).
+		From("pests_images AS i").
+		LeftJoin("media AS m ON m.id = i.media_id").
+		Where(sq.Eq{"i.pest_id": pestId}).
+		OrderBy("i.id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "pestImagesRepository.GetAll",
+		QueryRaw: queryRaw,
+	}
+
+	var imgs []string
+	if err = r.dbc.DB().ScanAllContext(ctx, &imgs, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return imgs, nil
+}
+
+type pestImage struct {
+	PestId int    `db:"pest_id"`
+	Img    string `db:"img"`
+}
+
+func (r *pestImagesRepository) GetAllByPestIds(ctx context.Context, pestIds []int) (map[int][]string, error) {
+	images := make(map[int][]string, len(pestIds))
+	if len(pestIds) == 0 {
+		return images, nil
+	}
+
+	queryRaw, args, err := sq.
+		Select("i.pest_id", imgColumn).
+		PlaceholderFormat(sq.Dollar).
+		From("pests_images AS i").
+		LeftJoin("media AS m ON m.id = i.media_id").
+		Where(sq.Eq{"i.pest_id": pestIds}).
+		OrderBy("i.id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "pestImagesRepository.GetAllByPestIds",
+		QueryRaw: queryRaw,
+	}
+
+	var rows []pestImage
+	if err = r.dbc.DB().ScanAllContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	for _, row := range rows {
+		images[row.PestId] = append(images[row.PestId], row.Img)
+	}
+
+	return images, nil
+}
+
+func (r *pestImagesRepository) DeleteBulk(ctx context.Context, pestId int) error {
+	queryRaw, args, err := sq.
+		Delete("pests_images").
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"pest_id": pestId}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "pestImagesRepository.DeleteBulk",
+		QueryRaw: queryRaw,
+	}
+
+	if _, err = r.dbc.DB().ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return nil
+}
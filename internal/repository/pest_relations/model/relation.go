@@ -0,0 +1,11 @@
+package model
+
+type Crop struct {
+	Id   int    `db:"id"`
+	Name string `db:"name"`
+}
+
+type Article struct {
+	Id    int    `db:"id"`
+	Title string `db:"title"`
+}
@@ -0,0 +1,168 @@
+package pest_relations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/nogavadu/articles-service/internal/lib/postgresErrors"
+	"github.com/nogavadu/articles-service/internal/repository"
+	pestRelationsRepoModel "github.com/nogavadu/articles-service/internal/repository/pest_relations/model"
+	"github.com/nogavadu/platform_common/pkg/db"
+)
+
+var (
+	ErrAlreadyExists       = errors.New("pest relation already exists")
+	ErrNotFound            = errors.New("pest relation not found")
+	ErrInvalidArguments    = errors.New("invalid arguments")
+	ErrInternalServerError = errors.New("internal server error")
+)
+
+// link is one of the tables tying pests to other entities.
+type link struct {
+	table  string
+	column string
+}
+
+var (
+	cropLink    = link{table: "pests_crops", column: "crop_id"}
+	articleLink = link{table: "pests_articles", column: "article_id"}
+)
+
+type pestRelationsRepository struct {
+	dbc db.Client
+}
+
+func New(dbc db.Client) repository.PestRelationsRepository {
+	return &pestRelationsRepository{
+		dbc: dbc,
+	}
+}
+
+func (r *pestRelationsRepository) GetCrops(ctx context.Context, pestId int) ([]pestRelationsRepoModel.Crop, error) {
+	queryRaw, args, err := sq.
+		Select("c.id", "c.name").
+		PlaceholderFormat(sq.Dollar).
+		From("pests_crops AS pc").
+		Join("crops AS c ON c.id = pc.crop_id").
+		Where(sq.Eq{"pc.pest_id": pestId, "c.deleted_at": nil}).
+		OrderBy("c.name").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "pestRelationsRepository.GetCrops",
+		QueryRaw: queryRaw,
+	}
+
+	var crops []pestRelationsRepoModel.Crop
+	if err = r.dbc.DB().ScanAllContext(ctx, &crops, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return crops, nil
+}
+
+func (r *pestRelationsRepository) GetArticles(ctx context.Context, pestId int) ([]pestRelationsRepoModel.Article, error) {
+	queryRaw, args, err := sq.
+		Select("a.id", "a.title").
+		PlaceholderFormat(sq.Dollar).
+		From("pests_articles AS pa").
+		Join("articles AS a ON a.id = pa.article_id").
+		Where(sq.Eq{"pa.pest_id": pestId, "a.deleted_at": nil}).
+		OrderBy("a.title").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     "pestRelationsRepository.GetArticles",
+		QueryRaw: queryRaw,
+	}
+
+	var articles []pestRelationsRepoModel.Article
+	if err = r.dbc.DB().ScanAllContext(ctx, &articles, query, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return articles, nil
+}
+
+func (r *pestRelationsRepository) AddCrop(ctx context.Context, pestId int, cropId int) error {
+	return r.add(ctx, "pestRelationsRepository.AddCrop", cropLink, pestId, cropId)
+}
+
+func (r *pestRelationsRepository) RemoveCrop(ctx context.Context, pestId int, cropId int) error {
+	return r.remove(ctx, "pestRelationsRepository.RemoveCrop", cropLink, pestId, cropId)
+}
+
+func (r *pestRelationsRepository) AddArticle(ctx context.Context, pestId int, articleId int) error {
+	return r.add(ctx, "pestRelationsRepository.AddArticle", articleLink, pestId, articleId)
+}
+
+func (r *pestRelationsRepository) RemoveArticle(ctx context.Context, pestId int, articleId int) error {
+	return r.remove(ctx, "pestRelationsRepository.RemoveArticle", articleLink, pestId, articleId)
+}
+
+func (r *pestRelationsRepository) add(ctx context.Context, name string, l link, pestId int, id int) error {
+	queryRaw, args, err := sq.
+		Insert(l.table).
+		PlaceholderFormat(sq.Dollar).
+		Columns("pest_id", l.column).
+		Values(pestId, id).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     name,
+		QueryRaw: queryRaw,
+	}
+
+	if _, err = r.dbc.DB().ExecContext(ctx, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == postgresErrors.AlreadyExistsErrCode {
+				return fmt.Errorf("%w: %w", ErrAlreadyExists, err)
+			}
+			if pgErr.Code == postgresErrors.InvalidForeignKeyErrCode {
+				return fmt.Errorf("%w: %w", ErrInvalidArguments, err)
+			}
+		}
+
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	return nil
+}
+
+func (r *pestRelationsRepository) remove(ctx context.Context, name string, l link, pestId int, id int) error {
+	queryRaw, args, err := sq.
+		Delete(l.table).
+		PlaceholderFormat(sq.Dollar).
+		Where(sq.Eq{"pest_id": pestId, l.column: id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+
+	query := db.Query{
+		Name:     name,
+		QueryRaw: queryRaw,
+	}
+
+	tag, err := r.dbc.DB().ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInternalServerError, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
@@ -3,9 +3,20 @@ package repository
 import (
 	"context"
 	articleRepoModel "github.com/nogavadu/articles-service/internal/repository/article/model"
+	articleImagesRepoModel "github.com/nogavadu/articles-service/internal/repository/article_images/model"
+	articleRelationsRepoModel "github.com/nogavadu/articles-service/internal/repository/article_relations/model"
+	revisionRepoModel "github.com/nogavadu/articles-service/internal/repository/article_revisions/model"
+	auditRepoModel "github.com/nogavadu/articles-service/internal/repository/audit_log/model"
 	categoryRepoModel "github.com/nogavadu/articles-service/internal/repository/category/model"
 	cropRepoModel "github.com/nogavadu/articles-service/internal/repository/crop/model"
+	calendarRepoModel "github.com/nogavadu/articles-service/internal/repository/crop_calendar/model"
+	companionRepoModel "github.com/nogavadu/articles-service/internal/repository/crop_companions/model"
+	mediaRepoModel "github.com/nogavadu/articles-service/internal/repository/media/model"
+	variantRepoModel "github.com/nogavadu/articles-service/internal/repository/media_variants/model"
+	pestRepoModel "github.com/nogavadu/articles-service/internal/repository/pest/model"
+	pestRelationsRepoModel "github.com/nogavadu/articles-service/internal/repository/pest_relations/model"
 	statusRepoModel "github.com/nogavadu/articles-service/internal/repository/status/model"
+	"time"
 )
 
 type CropRepository interface {
@@ -13,7 +24,32 @@ type CropRepository interface {
 	GetAll(ctx context.Context, statusId int) ([]cropRepoModel.Crop, error)
 	GetById(ctx context.Context, id int) (*cropRepoModel.Crop, error)
 	Update(ctx context.Context, id int, input *cropRepoModel.UpdateInput) error
+	SetParent(ctx context.Context, id int, parentId *int) error
+	GetAncestorIds(ctx context.Context, id int) ([]int, error)
+	LockAncestorIds(ctx context.Context, ids []int) ([]int, error)
 	Delete(ctx context.Context, id int) error
+
+	GetDeleted(ctx context.Context) ([]cropRepoModel.Crop, error)
+	Restore(ctx context.Context, id int) error
+	HardDelete(ctx context.Context, id int) error
+	Purge(ctx context.Context, deletedBefore time.Time) ([]int, error)
+}
+
+type CropCalendarRepository interface {
+	Create(ctx context.Context, cropId int, info *calendarRepoModel.EntryInfo) (int, error)
+	GetAll(ctx context.Context, cropId int) ([]calendarRepoModel.Entry, error)
+	GetById(ctx context.Context, cropId int, id int) (*calendarRepoModel.Entry, error)
+	GetByZone(ctx context.Context, params *calendarRepoModel.ZoneParams) ([]calendarRepoModel.ZoneEntry, error)
+	Update(ctx context.Context, cropId int, id int, input *calendarRepoModel.UpdateInput) error
+	Delete(ctx context.Context, cropId int, id int) error
+}
+
+type CropCompanionsRepository interface {
+	GetAll(ctx context.Context, cropId int) ([]companionRepoModel.Companion, error)
+	Get(ctx context.Context, cropId int, companionId int) (*companionRepoModel.CompanionInfo, error)
+	Set(ctx context.Context, cropId int, companionId int, info *companionRepoModel.CompanionInfo) error
+	Delete(ctx context.Context, cropId int, companionId int) error
+	GetConflicts(ctx context.Context, cropIds []int) ([]companionRepoModel.Conflict, error)
 }
 
 type CategoryRepository interface {
@@ -21,7 +57,15 @@ type CategoryRepository interface {
 	GetAll(ctx context.Context, params *categoryRepoModel.CategoryGetAllParams) ([]categoryRepoModel.Category, error)
 	GetById(ctx context.Context, id int) (*categoryRepoModel.Category, error)
 	Update(ctx context.Context, id int, input *categoryRepoModel.UpdateInput) error
+	Move(ctx context.Context, id int, parentId *int, position int) error
+	GetAncestorIds(ctx context.Context, id int) ([]int, error)
+	LockAncestorIds(ctx context.Context, ids []int) ([]int, error)
 	Delete(ctx context.Context, id int) error
+
+	GetDeleted(ctx context.Context) ([]categoryRepoModel.Category, error)
+	Restore(ctx context.Context, id int) error
+	HardDelete(ctx context.Context, id int) error
+	Purge(ctx context.Context, deletedBefore time.Time) ([]int, error)
 }
 
 type CropCategoriesRepository interface {
@@ -32,24 +76,97 @@ type CropCategoriesRepository interface {
 type ArticleRepository interface {
 	Create(ctx context.Context, articleBody *articleRepoModel.ArticleBody) (int, error)
 	GetAll(ctx context.Context, params *articleRepoModel.ArticleGetAllParams) ([]articleRepoModel.Article, error)
+	Count(ctx context.Context, params *articleRepoModel.ArticleGetAllParams) (int, error)
 	GetById(ctx context.Context, id int) (*articleRepoModel.Article, error)
 	Update(ctx context.Context, id int, input *articleRepoModel.UpdateInput) error
 	Delete(ctx context.Context, id int) error
+
+	GetUnrendered(ctx context.Context, afterId int, limit uint64) ([]articleRepoModel.Article, error)
+	SetRendered(ctx context.Context, id int, html string, toc []byte) error
+
+	GetDeleted(ctx context.Context) ([]articleRepoModel.Article, error)
+	Restore(ctx context.Context, id int) error
+	HardDelete(ctx context.Context, id int) error
+	Purge(ctx context.Context, deletedBefore time.Time) ([]int, error)
 }
 
 type ArticleRelationsRepository interface {
 	Create(ctx context.Context, cropId int, categoryId int, articleId int) error
+	GetAll(ctx context.Context, articleId int) ([]articleRelationsRepoModel.Relation, error)
+	Delete(ctx context.Context, cropId int, categoryId int, articleId int) error
+	GetInconsistent(ctx context.Context) ([]articleRelationsRepoModel.ArticleRelation, error)
 }
 
 type ArticleImagesRepository interface {
-	CreateBulk(ctx context.Context, articleId int, images []string) error
-	GetAll(ctx context.Context, articleId int) ([]string, error)
+	CreateBulk(ctx context.Context, articleId int, mediaIds []int) error
+	Create(ctx context.Context, articleId int, info *articleImagesRepoModel.ImageInfo) (int, error)
+	GetById(ctx context.Context, id int) (*articleImagesRepoModel.Image, error)
+	GetAll(ctx context.Context, articleId int) ([]articleImagesRepoModel.Image, error)
+	GetAllByArticleIds(ctx context.Context, articleIds []int) (map[int][]articleImagesRepoModel.Image, error)
+	Update(ctx context.Context, id int, input *articleImagesRepoModel.UpdateInput) error
+	SetPositions(ctx context.Context, articleId int, imageIds []int) error
+	Delete(ctx context.Context, id int) error
 	DeleteBulk(ctx context.Context, articleId int) error
 }
 
+type ArticleRevisionsRepository interface {
+	Create(ctx context.Context, info *revisionRepoModel.RevisionInfo) (int, error)
+	GetAll(ctx context.Context, articleId int) ([]revisionRepoModel.Revision, error)
+	GetById(ctx context.Context, articleId int, id int) (*revisionRepoModel.Revision, error)
+}
+
+type PestRepository interface {
+	Create(ctx context.Context, info *pestRepoModel.PestInfo) (int, error)
+	GetAll(ctx context.Context, params *pestRepoModel.PestGetAllParams) ([]pestRepoModel.Pest, error)
+	GetById(ctx context.Context, id int) (*pestRepoModel.Pest, error)
+	Update(ctx context.Context, id int, input *pestRepoModel.UpdateInput) error
+	Delete(ctx context.Context, id int) error
+
+	GetDeleted(ctx context.Context) ([]pestRepoModel.Pest, error)
+	Restore(ctx context.Context, id int) error
+	HardDelete(ctx context.Context, id int) error
+	Purge(ctx context.Context, deletedBefore time.Time) ([]int, error)
+}
+
+type PestImagesRepository interface {
+	CreateBulk(ctx context.Context, pestId int, mediaIds []int) error
+	GetAll(ctx context.Context, pestId int) ([]string, error)
+	GetAllByPestIds(ctx context.Context, pestIds []int) (map[int][]string, error)
+	DeleteBulk(ctx context.Context, pestId int) error
+}
+
+type PestRelationsRepository interface {
+	GetCrops(ctx context.Context, pestId int) ([]pestRelationsRepoModel.Crop, error)
+	AddCrop(ctx context.Context, pestId int, cropId int) error
+	RemoveCrop(ctx context.Context, pestId int, cropId int) error
+
+	GetArticles(ctx context.Context, pestId int) ([]pestRelationsRepoModel.Article, error)
+	AddArticle(ctx context.Context, pestId int, articleId int) error
+	RemoveArticle(ctx context.Context, pestId int, articleId int) error
+}
+
 type StatusRepository interface {
 	Create(ctx context.Context, status string) (int, error)
 	GetAll(ctx context.Context) ([]statusRepoModel.Status, error)
+	GetMap(ctx context.Context) (map[int]string, error)
 	GetByStatus(ctx context.Context, status string) (*statusRepoModel.Status, error)
 	GetById(ctx context.Context, id int) (*statusRepoModel.Status, error)
 }
+
+type AuditLogRepository interface {
+	Create(ctx context.Context, info *auditRepoModel.EntryInfo) (int64, error)
+	GetAll(ctx context.Context, params *auditRepoModel.GetAllParams) ([]auditRepoModel.Entry, error)
+}
+
+type MediaRepository interface {
+	Create(ctx context.Context, info *mediaRepoModel.MediaInfo) (int, error)
+	GetById(ctx context.Context, id int) (*mediaRepoModel.Media, error)
+	GetByKey(ctx context.Context, key string) (*mediaRepoModel.Media, error)
+	GetByChecksum(ctx context.Context, checksum string) (*mediaRepoModel.Media, error)
+}
+
+type MediaVariantsRepository interface {
+	CreateBulk(ctx context.Context, mediaId int, variants []variantRepoModel.VariantInfo) error
+	GetAllByMediaIds(ctx context.Context, mediaIds []int) (map[int][]variantRepoModel.Variant, error)
+	GetByKey(ctx context.Context, key string) (*variantRepoModel.Variant, error)
+}
@@ -67,6 +67,21 @@ func (r *statusRepository) GetAll(ctx context.Context) ([]model.Status, error) {
 	return statuses, nil
 }
 
+// GetMap returns status names by id, the table is tiny so list endpoints load it once per request.
+func (r *statusRepository) GetMap(ctx context.Context) (map[int]string, error) {
+	statuses, err := r.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statusMap := make(map[int]string, len(statuses))
+	for _, s := range statuses {
+		statusMap[s.Id] = s.Status
+	}
+
+	return statusMap, nil
+}
+
 func (r *statusRepository) GetByStatus(ctx context.Context, status string) (*model.Status, error) {
 	queryRaw, args, err := sq.
 		Select("id", "status").
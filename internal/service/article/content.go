@@ -0,0 +1,81 @@
+package article
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/nogavadu/articles-service/internal/domain/converter"
+	"github.com/nogavadu/articles-service/internal/lib/markup"
+	"log/slog"
+)
+
+const renderBatchSize = 100
+
+// RenderPending renders the html and toc of the articles written before rendering existed
+// and returns how many were rendered. It goes through the articles once, so a failing article is not retried.
+func (s *articleService) RenderPending(ctx context.Context) (int, error) {
+	const op = "articleService.RenderPending"
+	log := s.log.With(slog.String("op", op))
+
+	var rendered, afterId int
+	for {
+		articles, err := s.articleRepo.GetUnrendered(ctx, afterId, renderBatchSize)
+		if err != nil {
+			log.Error("failed to get unrendered articles", slog.String("error", err.Error()))
+			return rendered, ErrInternalServerError
+		}
+		if len(articles) == 0 {
+			return rendered, nil
+		}
+
+		for _, article := range articles {
+			afterId = article.Id
+
+			html, toc, err := renderContent(article.Format, article.Text)
+			if err != nil {
+				log.Error("failed to render article",
+					slog.Int("article_id", article.Id),
+					slog.String("error", err.Error()),
+				)
+				continue
+			}
+
+			if err = s.articleRepo.SetRendered(ctx, article.Id, html, toc); err != nil {
+				log.Error("failed to store rendered article",
+					slog.Int("article_id", article.Id),
+					slog.String("error", err.Error()),
+				)
+				return rendered, ErrInternalServerError
+			}
+			rendered++
+		}
+	}
+}
+
+// renderContent returns the html and the json encoded toc of the text, toc is nil when the text has no headings.
+// The format is checked even when there is no text.
+func renderContent(format string, text *string) (string, []byte, error) {
+	var source string
+	if text != nil {
+		source = *text
+	}
+
+	html, headings, err := markup.Render(format, source)
+	if err != nil || len(headings) == 0 {
+		return html, nil, err
+	}
+
+	toc, err := json.Marshal(converter.ToArticleToc(headings))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return html, toc, nil
+}
+
+func renderErr(err error) error {
+	if errors.Is(err, markup.ErrUnknownFormat) {
+		return ErrInvalidArguments
+	}
+	return ErrInternalServerError
+}
@@ -0,0 +1,241 @@
+package article
+
+import (
+	"context"
+	"errors"
+	"github.com/nogavadu/articles-service/internal/domain/converter"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	articleRepo "github.com/nogavadu/articles-service/internal/repository/article"
+	articleImagesRepo "github.com/nogavadu/articles-service/internal/repository/article_images"
+	articleImagesRepoModel "github.com/nogavadu/articles-service/internal/repository/article_images/model"
+	"log/slog"
+	"slices"
+)
+
+func (s *articleService) AddImage(ctx context.Context, articleId int, input *model.ArticleImageInput) (int, error) {
+	const op = "articleService.AddImage"
+	log := s.log.With(slog.String("op", op))
+
+	var imageId int
+	err := s.editImages(ctx, log, articleId, func(ctx context.Context) error {
+		id, err := s.articleImagesRepo.Create(ctx, articleId, converter.ToRepoArticleImageInfo(input))
+		if err != nil {
+			log.Error("failed to add article image", slog.String("error", err.Error()))
+			return imagesErr(err)
+		}
+		imageId = id
+
+		if input.Position == nil {
+			return nil
+		}
+
+		images, err := s.articleImagesRepo.GetAll(ctx, articleId)
+		if err != nil {
+			log.Error("failed to get article images", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+
+		order := make([]int, 0, len(images))
+		for _, image := range images {
+			if image.Id != imageId {
+				order = append(order, image.Id)
+			}
+		}
+		order = slices.Insert(order, min(*input.Position, len(order)), imageId)
+
+		if err = s.articleImagesRepo.SetPositions(ctx, articleId, order); err != nil {
+			log.Error("failed to reorder article images", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+
+		return nil
+	})
+
+	return imageId, err
+}
+
+func (s *articleService) UpdateImage(ctx context.Context, articleId int, imageId int, input *model.ArticleImageDetails) error {
+	const op = "articleService.UpdateImage"
+	log := s.log.With(slog.String("op", op))
+
+	return s.editImages(ctx, log, articleId, func(ctx context.Context) error {
+		if err := s.checkImage(ctx, articleId, imageId); err != nil {
+			log.Error("failed to get article image", slog.String("error", err.Error()))
+			return err
+		}
+
+		if err := s.articleImagesRepo.Update(ctx, imageId, converter.ToRepoArticleImageUpdateInput(input)); err != nil {
+			log.Error("failed to update article image", slog.String("error", err.Error()))
+			if errors.Is(err, articleImagesRepo.ErrNotFound) {
+				return ErrImageNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		return nil
+	})
+}
+
+// ReorderImages sets the image positions to the order of imageIds, which must list every image of the article.
+func (s *articleService) ReorderImages(ctx context.Context, articleId int, imageIds []int) error {
+	const op = "articleService.ReorderImages"
+	log := s.log.With(slog.String("op", op))
+
+	return s.editImages(ctx, log, articleId, func(ctx context.Context) error {
+		images, err := s.articleImagesRepo.GetAll(ctx, articleId)
+		if err != nil {
+			log.Error("failed to get article images", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+
+		if len(images) != len(imageIds) {
+			return ErrInvalidImageOrder
+		}
+		for _, image := range images {
+			if !slices.Contains(imageIds, image.Id) {
+				return ErrInvalidImageOrder
+			}
+		}
+
+		if err = s.articleImagesRepo.SetPositions(ctx, articleId, imageIds); err != nil {
+			log.Error("failed to reorder article images", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+
+		return nil
+	})
+}
+
+// RemoveImage deletes the image and closes the gap it leaves in the positions.
+func (s *articleService) RemoveImage(ctx context.Context, articleId int, imageId int) error {
+	const op = "articleService.RemoveImage"
+	log := s.log.With(slog.String("op", op))
+
+	return s.editImages(ctx, log, articleId, func(ctx context.Context) error {
+		if err := s.checkImage(ctx, articleId, imageId); err != nil {
+			log.Error("failed to get article image", slog.String("error", err.Error()))
+			return err
+		}
+
+		if err := s.articleImagesRepo.Delete(ctx, imageId); err != nil {
+			log.Error("failed to remove article image", slog.String("error", err.Error()))
+			if errors.Is(err, articleImagesRepo.ErrNotFound) {
+				return ErrImageNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		images, err := s.articleImagesRepo.GetAll(ctx, articleId)
+		if err != nil {
+			log.Error("failed to get article images", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+		if err = s.articleImagesRepo.SetPositions(ctx, articleId, imageIdsOf(images)); err != nil {
+			log.Error("failed to reorder article images", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+
+		return nil
+	})
+}
+
+// editImages runs edit in a transaction once the caller is allowed to modify the article,
+// the images are part of the article so the change is audited as an article update.
+func (s *articleService) editImages(ctx context.Context, log *slog.Logger, articleId int, edit func(ctx context.Context) error) error {
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		before, err := s.snapshots.Article(ctx, articleId)
+		if err != nil {
+			log.Error("failed to get article", slog.String("error", err.Error()))
+			if errors.Is(err, articleRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+		if err = s.policy.CanModify(ctx, before.Author, before.Status); err != nil {
+			log.Error("access check failed", slog.String("error", err.Error()))
+			return ErrAccessDenied
+		}
+
+		if err = edit(ctx); err != nil {
+			return err
+		}
+
+		after, err := s.snapshots.Article(ctx, articleId)
+		if err != nil {
+			log.Error("failed to get article", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+
+		return s.audit.Record(ctx, model.EntityArticle, articleId, model.AuditActionUpdate, before, after)
+	})
+}
+
+// checkImage makes sure the image belongs to the article.
+func (s *articleService) checkImage(ctx context.Context, articleId int, imageId int) error {
+	image, err := s.articleImagesRepo.GetById(ctx, imageId)
+	if err != nil {
+		if errors.Is(err, articleImagesRepo.ErrNotFound) {
+			return ErrImageNotFound
+		}
+
+		return ErrInternalServerError
+	}
+	if image.ArticleId != articleId {
+		return ErrImageNotFound
+	}
+
+	return nil
+}
+
+// syncImages makes the article images match mediaIds in order. Images whose media stays keep their details,
+// the others are removed and the new media are added.
+func (s *articleService) syncImages(ctx context.Context, articleId int, mediaIds []int) error {
+	images, err := s.articleImagesRepo.GetAll(ctx, articleId)
+	if err != nil {
+		return err
+	}
+
+	kept := make(map[int][]int, len(images))
+	for _, image := range images {
+		if image.MediaId != nil {
+			kept[*image.MediaId] = append(kept[*image.MediaId], image.Id)
+		}
+	}
+
+	order := make([]int, 0, len(mediaIds))
+	for _, mediaId := range mediaIds {
+		if ids := kept[mediaId]; len(ids) > 0 {
+			order = append(order, ids[0])
+			kept[mediaId] = ids[1:]
+			continue
+		}
+
+		id, err := s.articleImagesRepo.Create(ctx, articleId, &articleImagesRepoModel.ImageInfo{MediaId: &mediaId})
+		if err != nil {
+			return err
+		}
+		order = append(order, id)
+	}
+
+	for _, image := range images {
+		if slices.Contains(order, image.Id) {
+			continue
+		}
+		if err = s.articleImagesRepo.Delete(ctx, image.Id); err != nil {
+			return err
+		}
+	}
+
+	return s.articleImagesRepo.SetPositions(ctx, articleId, order)
+}
+
+func imageIdsOf(images []articleImagesRepoModel.Image) []int {
+	ids := make([]int, 0, len(images))
+	for _, image := range images {
+		ids = append(ids, image.Id)
+	}
+	return ids
+}
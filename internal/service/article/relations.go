@@ -0,0 +1,149 @@
+package article
+
+import (
+	"context"
+	"errors"
+	authService "github.com/nogavadu/articles-service/internal/clients/auth-service/grpc"
+	"github.com/nogavadu/articles-service/internal/domain/converter"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	articleRepo "github.com/nogavadu/articles-service/internal/repository/article"
+	articleRelationsRepo "github.com/nogavadu/articles-service/internal/repository/article_relations"
+	"log/slog"
+)
+
+func (s *articleService) GetRelations(ctx context.Context, articleId int) ([]model.ArticleRelation, error) {
+	const op = "articleService.GetRelations"
+	log := s.log.With(slog.String("op", op))
+
+	var relations []model.ArticleRelation
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if _, err := s.articleRepo.GetById(ctx, articleId); err != nil {
+			log.Error("failed to get article", slog.String("error", err.Error()))
+			if errors.Is(err, articleRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		repoRelations, err := s.articleRelationsRepo.GetAll(ctx, articleId)
+		if err != nil {
+			log.Error("failed to get article relations", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+		relations = converter.ToArticleRelations(repoRelations)
+
+		return nil
+	})
+
+	return relations, err
+}
+
+func (s *articleService) AddRelation(ctx context.Context, articleId int, relation *model.ArticleRelation) error {
+	const op = "articleService.AddRelation"
+	log := s.log.With(slog.String("op", op))
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		article, err := s.snapshots.Article(ctx, articleId)
+		if err != nil {
+			log.Error("failed to get article", slog.String("error", err.Error()))
+			if errors.Is(err, articleRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+		if err = s.policy.CanModify(ctx, article.Author, article.Status); err != nil {
+			log.Error("access check failed", slog.String("error", err.Error()))
+			return ErrAccessDenied
+		}
+
+		if err = s.articleRelationsRepo.Create(ctx, relation.CropId, relation.CategoryId, articleId); err != nil {
+			log.Error("failed to add article relation", slog.String("error", err.Error()))
+			return relationErr(err)
+		}
+
+		return s.audit.Record(ctx, model.EntityArticle, articleId, model.AuditActionAddRelation, nil, relation)
+	})
+}
+
+// RemoveRelation unlinks the article from a (crop, category) pair, the last pair can't be removed
+// since the article would no longer be reachable from any crop.
+func (s *articleService) RemoveRelation(ctx context.Context, articleId int, relation *model.ArticleRelation) error {
+	const op = "articleService.RemoveRelation"
+	log := s.log.With(slog.String("op", op))
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		article, err := s.snapshots.Article(ctx, articleId)
+		if err != nil {
+			log.Error("failed to get article", slog.String("error", err.Error()))
+			if errors.Is(err, articleRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+		if err = s.policy.CanModify(ctx, article.Author, article.Status); err != nil {
+			log.Error("access check failed", slog.String("error", err.Error()))
+			return ErrAccessDenied
+		}
+
+		if err = s.articleRelationsRepo.Delete(ctx, relation.CropId, relation.CategoryId, articleId); err != nil {
+			log.Error("failed to remove article relation", slog.String("error", err.Error()))
+			if errors.Is(err, articleRelationsRepo.ErrNotFound) {
+				return ErrRelationNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		remaining, err := s.articleRelationsRepo.GetAll(ctx, articleId)
+		if err != nil {
+			log.Error("failed to get article relations", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+		if len(remaining) == 0 {
+			return ErrNoRelations
+		}
+
+		return s.audit.Record(ctx, model.EntityArticle, articleId, model.AuditActionRemoveRelation, relation, nil)
+	})
+}
+
+// GetInconsistentRelations lists the article relations whose category isn't linked to the crop,
+// such articles don't show up in the crop category tree.
+func (s *articleService) GetInconsistentRelations(ctx context.Context) ([]model.InconsistentArticleRelation, error) {
+	const op = "articleService.GetInconsistentRelations"
+	log := s.log.With(slog.String("op", op))
+
+	token, err := s.authClient.AccessToken(ctx)
+	if err != nil {
+		log.Error("failed to get access token", slog.String("error", err.Error()))
+		return nil, ErrAccessDenied
+	}
+	if err = s.accessClient.Check(ctx, token, authService.ModeratorAccessLevel); err != nil {
+		log.Error("access check failed", slog.String("error", err.Error()))
+		return nil, ErrAccessDenied
+	}
+
+	repoRelations, err := s.articleRelationsRepo.GetInconsistent(ctx)
+	if err != nil {
+		log.Error("failed to get inconsistent article relations", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	return converter.ToInconsistentArticleRelations(repoRelations), nil
+}
+
+func relationErr(err error) error {
+	switch {
+	case errors.Is(err, articleRelationsRepo.ErrAlreadyExists):
+		return ErrRelationExists
+	case errors.Is(err, articleRelationsRepo.ErrUnlinkedPair):
+		return ErrUnlinkedPair
+	case errors.Is(err, articleRelationsRepo.ErrInvalidArguments):
+		return ErrRelatedNotFound
+	default:
+		return ErrInternalServerError
+	}
+}
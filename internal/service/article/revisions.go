@@ -0,0 +1,168 @@
+package article
+
+import (
+	"context"
+	"errors"
+	authService "github.com/nogavadu/articles-service/internal/clients/auth-service/grpc"
+	"github.com/nogavadu/articles-service/internal/domain/converter"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/diff"
+	articleRepo "github.com/nogavadu/articles-service/internal/repository/article"
+	revisionRepo "github.com/nogavadu/articles-service/internal/repository/article_revisions"
+	"log/slog"
+)
+
+func (s *articleService) GetRevisions(ctx context.Context, articleId int) ([]model.ArticleRevision, error) {
+	const op = "articleService.GetRevisions"
+	log := s.log.With(slog.String("op", op))
+
+	if err := s.checkExists(ctx, log, articleId); err != nil {
+		return nil, err
+	}
+
+	repoRevisions, err := s.articleRevisionsRepo.GetAll(ctx, articleId)
+	if err != nil {
+		log.Error("failed to get article revisions", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	authorIds := make([]int, 0, len(repoRevisions))
+	for _, r := range repoRevisions {
+		if r.Author != nil {
+			authorIds = append(authorIds, *r.Author)
+		}
+	}
+
+	authors, err := s.userClient.GetByIds(ctx, authorIds)
+	if err != nil {
+		log.Error("failed to get revision authors", slog.String("error", err.Error()))
+	}
+
+	revisions := make([]model.ArticleRevision, 0, len(repoRevisions))
+	for _, r := range repoRevisions {
+		var author *model.User
+		if r.Author != nil {
+			author = authors[*r.Author]
+		}
+
+		revisions = append(revisions, *converter.ToArticleRevision(&r, author))
+	}
+
+	return revisions, nil
+}
+
+func (s *articleService) GetRevision(ctx context.Context, articleId int, revisionId int) (*model.ArticleRevision, error) {
+	const op = "articleService.GetRevision"
+	log := s.log.With(slog.String("op", op))
+
+	if err := s.checkExists(ctx, log, articleId); err != nil {
+		return nil, err
+	}
+
+	repoRevision, err := s.articleRevisionsRepo.GetById(ctx, articleId, revisionId)
+	if err != nil {
+		log.Error("failed to get article revision", slog.String("error", err.Error()))
+		if errors.Is(err, revisionRepo.ErrNotFound) {
+			return nil, ErrRevisionNotFound
+		}
+
+		return nil, ErrInternalServerError
+	}
+
+	var author *model.User
+	if repoRevision.Author != nil {
+		author, err = s.userClient.GetById(ctx, *repoRevision.Author)
+		if err != nil {
+			log.Error("failed to get revision author", slog.String("error", err.Error()))
+		}
+	}
+
+	return converter.ToArticleRevision(repoRevision, author), nil
+}
+
+func (s *articleService) DiffRevisions(
+	ctx context.Context,
+	articleId int,
+	fromId int,
+	toId int,
+) (*model.ArticleRevisionDiff, error) {
+	const op = "articleService.DiffRevisions"
+	log := s.log.With(slog.String("op", op))
+
+	if err := s.checkExists(ctx, log, articleId); err != nil {
+		return nil, err
+	}
+
+	var revisions [2]*model.ArticleRevision
+	for i, id := range []int{fromId, toId} {
+		repoRevision, err := s.articleRevisionsRepo.GetById(ctx, articleId, id)
+		if err != nil {
+			log.Error("failed to get article revision", slog.String("error", err.Error()))
+			if errors.Is(err, revisionRepo.ErrNotFound) {
+				return nil, ErrRevisionNotFound
+			}
+
+			return nil, ErrInternalServerError
+		}
+		revisions[i] = converter.ToArticleRevision(repoRevision, nil)
+	}
+	from, to := revisions[0], revisions[1]
+
+	return &model.ArticleRevisionDiff{
+		From:      from.Id,
+		To:        to.Id,
+		Title:     diff.Lines(from.Title, to.Title),
+		LatinName: diff.Lines(deref(from.LatinName), deref(to.LatinName)),
+		Text:      diff.Lines(deref(from.Text), deref(to.Text)),
+	}, nil
+}
+
+// RestoreRevision applies the revision content as a new edit, so the restore itself shows up in the history.
+func (s *articleService) RestoreRevision(ctx context.Context, articleId int, revisionId int) error {
+	const op = "articleService.RestoreRevision"
+	log := s.log.With(slog.String("op", op))
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		token, err := s.authClient.AccessToken(ctx)
+		if err != nil {
+			log.Error("failed to get access token", slog.String("error", err.Error()))
+			return ErrAccessDenied
+		}
+		if err = s.accessClient.Check(ctx, token, authService.ModeratorAccessLevel); err != nil {
+			log.Error("access check failed", slog.String("error", err.Error()))
+			return ErrAccessDenied
+		}
+
+		revision, err := s.articleRevisionsRepo.GetById(ctx, articleId, revisionId)
+		if err != nil {
+			log.Error("failed to get article revision", slog.String("error", err.Error()))
+			if errors.Is(err, revisionRepo.ErrNotFound) {
+				return ErrRevisionNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		return s.Update(ctx, articleId, converter.RevisionToArticleUpdateInput(revision))
+	})
+}
+
+// checkExists keeps the history of unknown and deleted articles hidden, revisions outlive the article row.
+func (s *articleService) checkExists(ctx context.Context, log *slog.Logger, articleId int) error {
+	if _, err := s.articleRepo.GetById(ctx, articleId); err != nil {
+		if errors.Is(err, articleRepo.ErrNotFound) {
+			return ErrNotFound
+		}
+
+		log.Error("failed to get article", slog.String("error", err.Error()))
+		return ErrInternalServerError
+	}
+	return nil
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
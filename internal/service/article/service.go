@@ -6,18 +6,43 @@ import (
 	authService "github.com/nogavadu/articles-service/internal/clients/auth-service/grpc"
 	"github.com/nogavadu/articles-service/internal/domain/converter"
 	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/apperr"
+	"github.com/nogavadu/articles-service/internal/lib/identity"
+	"github.com/nogavadu/articles-service/internal/lib/pagination"
 	"github.com/nogavadu/articles-service/internal/repository"
 	articleRepo "github.com/nogavadu/articles-service/internal/repository/article"
+	articleRepoModel "github.com/nogavadu/articles-service/internal/repository/article/model"
+	articleImagesRepo "github.com/nogavadu/articles-service/internal/repository/article_images"
+	articleImagesRepoModel "github.com/nogavadu/articles-service/internal/repository/article_images/model"
 	"github.com/nogavadu/articles-service/internal/service"
+	"github.com/nogavadu/articles-service/internal/service/workflow"
 	"github.com/nogavadu/platform_common/pkg/db"
 	"log/slog"
 )
 
 var (
-	ErrAlreadyExists       = errors.New("article already exists")
-	ErrInvalidArguments    = errors.New("invalid article arguments")
+	ErrNotFound            = apperr.New(apperr.ErrNotFound, "article not found")
+	ErrAlreadyExists       = apperr.New(apperr.ErrAlreadyExists, "article already exists")
+	ErrInvalidArguments    = apperr.New(apperr.ErrInvalidArguments, "invalid article arguments")
 	ErrInternalServerError = errors.New("internal server error")
-	ErrAccessDenied        = errors.New("access denied")
+	ErrAccessDenied        = apperr.New(apperr.ErrAccessDenied, "access denied")
+	ErrRevisionNotFound    = apperr.New(apperr.ErrNotFound, "article revision not found")
+	ErrIllegalTransition   = apperr.New(apperr.ErrIllegalTransition, "illegal status transition")
+	ErrRelatedNotFound     = apperr.New(apperr.ErrNotFound, "crop or category not found")
+	ErrRelationNotFound    = apperr.New(apperr.ErrNotFound, "article relation not found")
+	ErrRelationExists      = apperr.New(apperr.ErrAlreadyExists, "article relation already exists")
+	ErrUnlinkedPair        = apperr.New(apperr.ErrInvalidArguments, "category is not linked to the crop")
+	ErrNoRelations         = apperr.New(apperr.ErrInvalidArguments, "article needs at least one crop and category")
+	ErrMediaNotFound       = apperr.New(apperr.ErrNotFound, "media not found")
+	ErrImageNotFound       = apperr.New(apperr.ErrNotFound, "article image not found")
+	ErrInvalidImageOrder   = apperr.New(apperr.ErrInvalidArguments, "image ids must list every article image exactly once")
+	ErrInvalidSort         = apperr.New(apperr.ErrInvalidArguments, "invalid sort")
+	ErrRankWithoutQuery    = apperr.New(apperr.ErrInvalidArguments, "sort by rank requires a search query")
+)
+
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
 )
 
 type articleService struct {
@@ -25,9 +50,16 @@ type articleService struct {
 
 	articleRepo          repository.ArticleRepository
 	articleImagesRepo    repository.ArticleImagesRepository
+	mediaVariantsRepo    repository.MediaVariantsRepository
 	articleRelationsRepo repository.ArticleRelationsRepository
+	articleRevisionsRepo repository.ArticleRevisionsRepository
 	statusRepo           repository.StatusRepository
 
+	workflow  service.StatusWorkflow
+	policy    service.AccessPolicy
+	audit     service.AuditService
+	snapshots service.SnapshotLoader
+
 	txManager db.TxManager
 
 	accessClient *authService.AccessServiceClient
@@ -39,8 +71,14 @@ func New(
 	log *slog.Logger,
 	articleRepository repository.ArticleRepository,
 	articleImagesRepo repository.ArticleImagesRepository,
+	mediaVariantsRepo repository.MediaVariantsRepository,
 	articleRelationsRepo repository.ArticleRelationsRepository,
+	articleRevisionsRepo repository.ArticleRevisionsRepository,
 	statusRepo repository.StatusRepository,
+	workflow service.StatusWorkflow,
+	policy service.AccessPolicy,
+	audit service.AuditService,
+	snapshots service.SnapshotLoader,
 	txManager db.TxManager,
 	accessClient *authService.AccessServiceClient,
 	authClient *authService.AuthServiceClient,
@@ -50,8 +88,14 @@ func New(
 		log:                  log,
 		articleRepo:          articleRepository,
 		articleImagesRepo:    articleImagesRepo,
+		mediaVariantsRepo:    mediaVariantsRepo,
 		articleRelationsRepo: articleRelationsRepo,
+		articleRevisionsRepo: articleRevisionsRepo,
 		statusRepo:           statusRepo,
+		workflow:             workflow,
+		policy:               policy,
+		audit:                audit,
+		snapshots:            snapshots,
 		txManager:            txManager,
 		accessClient:         accessClient,
 		authClient:           authClient,
@@ -59,10 +103,24 @@ func New(
 	}
 }
 
-func (s *articleService) Create(ctx context.Context, userId, cropId int, categoryId int, articleBody *model.ArticleBody) (int, error) {
+func (s *articleService) Create(
+	ctx context.Context,
+	relations []model.ArticleRelation,
+	articleBody *model.ArticleBody,
+) (int, error) {
 	const op = "articleService.Create"
 	log := s.log.With(slog.String("op", op))
 
+	if len(relations) == 0 {
+		return 0, ErrNoRelations
+	}
+
+	userId, ok := identity.UserId(ctx)
+	if !ok {
+		log.Error("caller is not authenticated")
+		return 0, ErrAccessDenied
+	}
+
 	var articleId int
 	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
 		var errTx error
@@ -72,29 +130,25 @@ func (s *articleService) Create(ctx context.Context, userId, cropId int, categor
 			}
 		}()
 
-		token, err := s.authClient.AccessToken(ctx)
+		statusId, err := s.workflow.InitialStatus(ctx, articleBody.Status)
 		if err != nil {
-			log.Error("failed to get access token", slog.String("error", err.Error()))
-			return ErrAccessDenied
+			log.Error("failed to resolve initial status", slog.String("error", err.Error()))
+			return workflow.MapError(err)
 		}
 
-		status, err := s.statusRepo.GetByStatus(ctx, articleBody.Status)
-		if err != nil {
-			log.Error("failed to get status", slog.String("error", err.Error()))
+		repoBody := converter.ToRepoArticleBody(articleBody, statusId, userId)
+		if repoBody.Format == "" {
+			repoBody.Format = model.ArticleFormatPlain
 		}
-
-		accessLevel := authService.ModeratorAccessLevel
-		if status != nil && status.Id == 2 {
-			accessLevel = authService.UserAccessLevel
+		html, toc, errTx := renderContent(repoBody.Format, repoBody.Text)
+		if errTx != nil {
+			return renderErr(errTx)
 		}
-
-		err = s.accessClient.Check(ctx, token, accessLevel)
-		if err != nil {
-			log.Error("access check failed", slog.String("error", err.Error()))
-			return ErrAccessDenied
+		if html != "" {
+			repoBody.Html, repoBody.Toc = &html, toc
 		}
 
-		articleId, errTx = s.articleRepo.Create(ctx, converter.ToRepoArticleBody(articleBody, status.Id, userId))
+		articleId, errTx = s.articleRepo.Create(ctx, repoBody)
 		if errTx != nil {
 			if errors.Is(errTx, articleRepo.ErrAlreadyExists) {
 				return ErrAlreadyExists
@@ -103,27 +157,72 @@ func (s *articleService) Create(ctx context.Context, userId, cropId int, categor
 			return ErrInternalServerError
 		}
 
-		if len(articleBody.Images) > 0 {
-			if errTx = s.articleImagesRepo.CreateBulk(ctx, articleId, articleBody.Images); errTx != nil {
-				return ErrInternalServerError
+		if len(articleBody.ImageIds) > 0 {
+			if errTx = s.articleImagesRepo.CreateBulk(ctx, articleId, articleBody.ImageIds); errTx != nil {
+				return imagesErr(errTx)
+			}
+		}
+
+		for _, relation := range relations {
+			if errTx = s.articleRelationsRepo.Create(ctx, relation.CropId, relation.CategoryId, articleId); errTx != nil {
+				return relationErr(errTx)
 			}
 		}
 
-		if errTx = s.articleRelationsRepo.Create(ctx, cropId, categoryId, articleId); errTx != nil {
+		if errTx = s.recordRevision(ctx, articleId, &userId); errTx != nil {
 			return ErrInternalServerError
 		}
 
+		after, errTx := s.snapshots.Article(ctx, articleId)
+		if errTx != nil {
+			return ErrInternalServerError
+		}
+		if errTx = s.audit.Record(ctx, model.EntityArticle, articleId, model.AuditActionCreate, nil, after); errTx != nil {
+			return errTx
+		}
+
 		return nil
 	})
 
 	return articleId, err
 }
 
-func (s *articleService) GetAll(ctx context.Context, params *model.ArticleGetAllParams) ([]model.Article, error) {
+func (s *articleService) GetAll(ctx context.Context, params *model.ArticleGetAllParams) (*model.ArticleList, error) {
 	const op = "articleService.GetAll"
 	log := s.log.With(slog.String("op", op))
 
-	var articles []model.Article
+	// Newest first by default, most relevant first when searching.
+	switch params.Sort {
+	case "":
+		params.Sort, params.Desc = model.ArticleSortCreatedAt, true
+		if params.Query != nil {
+			params.Sort = model.ArticleSortRank
+		}
+	case model.ArticleSortCreatedAt, model.ArticleSortUpdatedAt, model.ArticleSortTitle:
+	case model.ArticleSortRank:
+		if params.Query == nil {
+			return nil, ErrRankWithoutQuery
+		}
+	default:
+		return nil, ErrInvalidSort
+	}
+	if params.Limit <= 0 || params.Limit > MaxPageLimit {
+		params.Limit = DefaultPageLimit
+	}
+
+	var cursor *articleRepoModel.ArticleCursor
+	if params.Cursor != nil {
+		c, err := pagination.DecodeCursor(*params.Cursor)
+		if err != nil || !c.Matches(params.Sort, params.Desc, params.Query) {
+			return nil, ErrInvalidArguments
+		}
+		cursor, err = converter.ToRepoArticleCursor(c, params.Sort)
+		if err != nil {
+			return nil, ErrInvalidArguments
+		}
+	}
+
+	var list *model.ArticleList
 	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
 		var errTx error
 		defer func() {
@@ -132,49 +231,84 @@ func (s *articleService) GetAll(ctx context.Context, params *model.ArticleGetAll
 			}
 		}()
 
-		var statusId int
-		if params.Status != nil {
-			status, errTx := s.statusRepo.GetByStatus(ctx, *params.Status)
-			if errTx != nil {
-				log.Error("failed to get status", slog.String("error", errTx.Error()))
-			}
-			if status != nil {
-				statusId = status.Id
-			}
-		} else {
-			statusId = 2
+		statusId, err := s.workflow.ListStatus(ctx, params.Status, model.StatusReview)
+		if err != nil {
+			return workflow.MapError(err)
+		}
+
+		repoParams := converter.ToRepoArticleGetAllParams(params, statusId, cursor)
+
+		total, errTx := s.articleRepo.Count(ctx, repoParams)
+		if errTx != nil {
+			return ErrInternalServerError
 		}
 
-		repoArticles, errTx := s.articleRepo.GetAll(ctx, converter.ToRepoArticleGetAllParams(params, statusId))
+		// One extra row tells whether there is a next page.
+		repoParams.Limit++
+		repoArticles, errTx := s.articleRepo.GetAll(ctx, repoParams)
 		if errTx != nil {
 			return ErrInternalServerError
 		}
 
-		articles = make([]model.Article, 0, len(repoArticles))
+		list = &model.ArticleList{
+			Total: total,
+		}
+		if len(repoArticles) > params.Limit {
+			repoArticles = repoArticles[:params.Limit]
+			nextCursor := pagination.EncodeCursor(
+				converter.ToArticleCursor(&repoArticles[len(repoArticles)-1], params),
+			)
+			list.NextCursor = &nextCursor
+		}
+
+		articleIds := make([]int, 0, len(repoArticles))
+		authorIds := make([]int, 0, len(repoArticles))
 		for _, a := range repoArticles {
-			imgs, errTx := s.articleImagesRepo.GetAll(ctx, a.Id)
-			if errTx != nil {
-				return ErrInternalServerError
+			articleIds = append(articleIds, a.Id)
+			if a.Author != nil {
+				authorIds = append(authorIds, *a.Author)
 			}
+		}
 
-			repoStatus, _ := s.statusRepo.GetById(ctx, a.Status)
+		images, errTx := s.articleImagesRepo.GetAllByArticleIds(ctx, articleIds)
+		if errTx != nil {
+			return ErrInternalServerError
+		}
+
+		var allImages []articleImagesRepoModel.Image
+		for _, articleImages := range images {
+			allImages = append(allImages, articleImages...)
+		}
+		variants, errTx := s.imageVariants(ctx, allImages)
+		if errTx != nil {
+			return ErrInternalServerError
+		}
+
+		statuses, errTx := s.statusRepo.GetMap(ctx)
+		if errTx != nil {
+			return ErrInternalServerError
+		}
+
+		authors, err := s.userClient.GetByIds(ctx, authorIds)
+		if err != nil {
+			log.Error("failed to get authors", slog.String("error", err.Error()))
+		}
 
+		articles := make([]model.Article, 0, len(repoArticles))
+		for _, a := range repoArticles {
 			var author *model.User
 			if a.Author != nil {
-				user, errTx := s.userClient.GetById(ctx, *a.Author)
-				if errTx != nil {
-					return ErrInternalServerError
-				}
-				author = user
+				author = authors[*a.Author]
 			}
 
-			articles = append(articles, *converter.ToArticle(&a, imgs, repoStatus.Status, author))
+			articles = append(articles, *converter.ToArticle(&a, converter.ToArticleImages(images[a.Id], variants), statuses[a.Status], author))
 		}
+		list.Articles = articles
 
 		return nil
 	})
 
-	return articles, err
+	return list, err
 }
 
 func (s *articleService) GetById(ctx context.Context, id int) (*model.Article, error) {
@@ -192,6 +326,9 @@ func (s *articleService) GetById(ctx context.Context, id int) (*model.Article, e
 
 		repoArticle, errTx := s.articleRepo.GetById(ctx, id)
 		if errTx != nil {
+			if errors.Is(errTx, articleRepo.ErrNotFound) {
+				return ErrNotFound
+			}
 			return ErrInternalServerError
 		}
 
@@ -200,6 +337,11 @@ func (s *articleService) GetById(ctx context.Context, id int) (*model.Article, e
 			return ErrInternalServerError
 		}
 
+		variants, errTx := s.imageVariants(ctx, images)
+		if errTx != nil {
+			return ErrInternalServerError
+		}
+
 		repoStatus, errTx := s.statusRepo.GetById(ctx, repoArticle.Status)
 		if errTx != nil {
 			return ErrInternalServerError
@@ -214,7 +356,13 @@ func (s *articleService) GetById(ctx context.Context, id int) (*model.Article, e
 			author = user
 		}
 
-		article = converter.ToArticle(repoArticle, images, repoStatus.Status, author)
+		relations, errTx := s.articleRelationsRepo.GetAll(ctx, repoArticle.Id)
+		if errTx != nil {
+			return ErrInternalServerError
+		}
+
+		article = converter.ToArticle(repoArticle, converter.ToArticleImages(images, variants), repoStatus.Status, author)
+		article.Relations = converter.ToArticleRelations(relations)
 
 		return nil
 	})
@@ -234,31 +382,76 @@ func (s *articleService) Update(ctx context.Context, id int, input *model.Articl
 			}
 		}()
 
+		before, errTx := s.snapshots.Article(ctx, id)
+		if errTx != nil {
+			if errors.Is(errTx, articleRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+			return ErrInternalServerError
+		}
+		if errTx = s.policy.CanModify(ctx, before.Author, before.Status); errTx != nil {
+			return ErrAccessDenied
+		}
+
+		var statusId *int
 		if input.Status != nil {
-			status, _ := s.statusRepo.GetByStatus(ctx, *input.Status)
-			if err := s.articleRepo.Update(ctx, id, converter.ToRepoArticleUpdateInput(input, &status.Id)); err != nil {
-				return ErrInternalServerError
+			newStatusId, err := s.workflow.Transition(ctx, before.Status, *input.Status)
+			if err != nil {
+				errTx = err
+				return workflow.MapError(err)
 			}
-		} else {
-			errTx = s.articleRepo.Update(ctx, id, converter.ToRepoArticleUpdateInput(input, nil))
-			if errTx != nil {
-				return ErrInternalServerError
+			statusId = &newStatusId
+		}
+
+		repoInput := converter.ToRepoArticleUpdateInput(input, statusId)
+		if input.Text != nil || input.ClearText || input.Format != nil {
+			text, format := before.Text, before.Format
+			if input.Text != nil || input.ClearText {
+				text = input.Text
 			}
+			if input.Format != nil {
+				format = *input.Format
+			}
+
+			html, toc, err := renderContent(format, text)
+			if err != nil {
+				errTx = err
+				return renderErr(err)
+			}
+			repoInput.Html, repoInput.Toc = &html, toc
 		}
 
-		if len(input.Images) > 0 {
-			errTx = s.articleImagesRepo.DeleteBulk(ctx, id)
-			if errTx != nil {
-				return ErrInternalServerError
+		errTx = s.articleRepo.Update(ctx, id, repoInput)
+		if errTx != nil {
+			if errors.Is(errTx, articleRepo.ErrNotFound) {
+				return ErrNotFound
 			}
+			return ErrInternalServerError
+		}
 
-			errTx = s.articleImagesRepo.CreateBulk(ctx, id, input.Images)
-			if errTx != nil {
+		if input.Title != nil || input.LatinName != nil || input.Text != nil || input.Format != nil ||
+			input.ClearLatinName || input.ClearText {
+			var editor *int
+			if userId, ok := identity.UserId(ctx); ok {
+				editor = &userId
+			}
+			if errTx = s.recordRevision(ctx, id, editor); errTx != nil {
 				return ErrInternalServerError
 			}
 		}
 
-		return nil
+		if input.ImageIds != nil {
+			if errTx = s.syncImages(ctx, id, input.ImageIds); errTx != nil {
+				return imagesErr(errTx)
+			}
+		}
+
+		after, errTx := s.snapshots.Article(ctx, id)
+		if errTx != nil {
+			return ErrInternalServerError
+		}
+
+		return s.audit.Record(ctx, model.EntityArticle, id, model.AuditActionUpdate, before, after)
 	})
 
 	return err
@@ -268,11 +461,65 @@ func (s *articleService) Delete(ctx context.Context, id int) error {
 	const op = "articleService.Delete"
 	log := s.log.With(slog.String("op", op))
 
-	err := s.articleRepo.Delete(ctx, id)
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		before, err := s.snapshots.Article(ctx, id)
+		if err != nil {
+			log.Error("failed to get article", slog.String("error", err.Error()))
+			if errors.Is(err, articleRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+		if err = s.policy.CanModify(ctx, before.Author, before.Status); err != nil {
+			log.Error("access check failed", slog.String("error", err.Error()))
+			return ErrAccessDenied
+		}
+
+		if err = s.articleRepo.Delete(ctx, id); err != nil {
+			log.Error("failed to delete article", slog.String("error", err.Error()))
+			if errors.Is(err, articleRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		return s.audit.Record(ctx, model.EntityArticle, id, model.AuditActionDelete, before, nil)
+	})
+}
+
+// recordRevision snapshots the current article content, it must be called inside the transaction that changed it.
+func (s *articleService) recordRevision(ctx context.Context, articleId int, author *int) error {
+	article, err := s.articleRepo.GetById(ctx, articleId)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.articleRevisionsRepo.Create(ctx, converter.ToRepoRevisionInfo(article, author))
+	return err
+}
+
+// imageVariants loads the variants of the uploaded images at once, keyed by media id.
+func (s *articleService) imageVariants(ctx context.Context, images []articleImagesRepoModel.Image) (map[int]model.ImageVariants, error) {
+	mediaIds := make([]int, 0, len(images))
+	for _, image := range images {
+		if image.MediaId != nil {
+			mediaIds = append(mediaIds, *image.MediaId)
+		}
+	}
+
+	variants, err := s.mediaVariantsRepo.GetAllByMediaIds(ctx, mediaIds)
 	if err != nil {
-		log.Error("failed to delete article", slog.String("error", err.Error()))
-		return ErrInternalServerError
+		return nil, err
 	}
 
-	return nil
+	return converter.ToMediaImageVariants(variants), nil
+}
+
+func imagesErr(err error) error {
+	if errors.Is(err, articleImagesRepo.ErrInvalidArguments) {
+		return ErrMediaNotFound
+	}
+	return ErrInternalServerError
 }
@@ -0,0 +1,139 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	authService "github.com/nogavadu/articles-service/internal/clients/auth-service/grpc"
+	"github.com/nogavadu/articles-service/internal/domain/converter"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/apperr"
+	"github.com/nogavadu/articles-service/internal/repository"
+	auditRepoModel "github.com/nogavadu/articles-service/internal/repository/audit_log/model"
+	"github.com/nogavadu/articles-service/internal/service"
+	"log/slog"
+	"reflect"
+)
+
+var (
+	ErrInvalidArguments    = apperr.New(apperr.ErrInvalidArguments, "invalid arguments")
+	ErrInternalServerError = errors.New("internal server error")
+	ErrAccessDenied        = apperr.New(apperr.ErrAccessDenied, "access denied")
+)
+
+const (
+	DefaultPageLimit = 100
+	MaxPageLimit     = 500
+)
+
+type auditService struct {
+	log *slog.Logger
+
+	auditLogRepo repository.AuditLogRepository
+
+	accessClient *authService.AccessServiceClient
+	authClient   *authService.AuthServiceClient
+}
+
+func New(
+	log *slog.Logger,
+	auditLogRepo repository.AuditLogRepository,
+	accessClient *authService.AccessServiceClient,
+	authClient *authService.AuthServiceClient,
+) service.AuditService {
+	return &auditService{
+		log:          log,
+		auditLogRepo: auditLogRepo,
+		accessClient: accessClient,
+		authClient:   authClient,
+	}
+}
+
+// Record stores a write operation together with the acting user taken from the request token.
+// It must be called inside the transaction that made the change, so the entry and the change commit together.
+func (s *auditService) Record(ctx context.Context, entityType string, entityId int, action string, before, after any) error {
+	const op = "auditService.Record"
+	log := s.log.With(slog.String("op", op))
+
+	var actor *int
+	// Writes made outside a request, such as the trash purge job, have no actor.
+	if userId, err := s.authClient.UserId(ctx); err != nil {
+		if !errors.Is(err, authService.ErrMissingToken) {
+			log.Warn("failed to resolve actor", slog.String("error", err.Error()))
+		}
+	} else {
+		actor = &userId
+	}
+
+	beforeJSON, err := snapshot(before)
+	if err != nil {
+		log.Error("failed to marshal snapshot", slog.String("error", err.Error()))
+		return ErrInternalServerError
+	}
+	afterJSON, err := snapshot(after)
+	if err != nil {
+		log.Error("failed to marshal snapshot", slog.String("error", err.Error()))
+		return ErrInternalServerError
+	}
+
+	_, err = s.auditLogRepo.Create(ctx, &auditRepoModel.EntryInfo{
+		Actor:      actor,
+		EntityType: entityType,
+		EntityId:   entityId,
+		Action:     action,
+		Before:     beforeJSON,
+		After:      afterJSON,
+	})
+	if err != nil {
+		log.Error("failed to create audit entry", slog.String("error", err.Error()))
+		return ErrInternalServerError
+	}
+
+	return nil
+}
+
+func (s *auditService) GetAll(ctx context.Context, params *model.AuditGetAllParams) ([]model.AuditEntry, error) {
+	const op = "auditService.GetAll"
+	log := s.log.With(slog.String("op", op))
+
+	token, err := s.authClient.AccessToken(ctx)
+	if err != nil {
+		log.Error("failed to get access token", slog.String("error", err.Error()))
+		return nil, ErrAccessDenied
+	}
+	if err = s.accessClient.Check(ctx, token, authService.AdminAccessLevel); err != nil {
+		log.Error("access check failed", slog.String("error", err.Error()))
+		return nil, ErrAccessDenied
+	}
+
+	if params.From != nil && params.To != nil && !params.From.Before(*params.To) {
+		return nil, ErrInvalidArguments
+	}
+	if params.Limit <= 0 || params.Limit > MaxPageLimit {
+		params.Limit = DefaultPageLimit
+	}
+
+	repoEntries, err := s.auditLogRepo.GetAll(ctx, converter.ToRepoAuditGetAllParams(params))
+	if err != nil {
+		log.Error("failed to get audit entries", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	entries := make([]model.AuditEntry, 0, len(repoEntries))
+	for _, e := range repoEntries {
+		entries = append(entries, *converter.ToAuditEntry(&e))
+	}
+
+	return entries, nil
+}
+
+func snapshot(v any) ([]byte, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return nil, nil
+	}
+
+	return json.Marshal(v)
+}
@@ -0,0 +1,184 @@
+package audit
+
+import (
+	"context"
+	"github.com/nogavadu/articles-service/internal/domain/converter"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/repository"
+	"github.com/nogavadu/articles-service/internal/service"
+)
+
+// snapshotLoader builds the before and after images of the audit trail. Every service loads entities through it,
+// so the entries of one entity keep the same shape whichever endpoint wrote them.
+// Authors are kept as ids, recording a change must not depend on the auth service.
+type snapshotLoader struct {
+	cropRepo          repository.CropRepository
+	categoryRepo      repository.CategoryRepository
+	articleRepo       repository.ArticleRepository
+	articleImagesRepo repository.ArticleImagesRepository
+	pestRepo          repository.PestRepository
+	pestImagesRepo    repository.PestImagesRepository
+	mediaVariantsRepo repository.MediaVariantsRepository
+	statusRepo        repository.StatusRepository
+}
+
+func NewSnapshotLoader(
+	cropRepo repository.CropRepository,
+	categoryRepo repository.CategoryRepository,
+	articleRepo repository.ArticleRepository,
+	articleImagesRepo repository.ArticleImagesRepository,
+	pestRepo repository.PestRepository,
+	pestImagesRepo repository.PestImagesRepository,
+	mediaVariantsRepo repository.MediaVariantsRepository,
+	statusRepo repository.StatusRepository,
+) service.SnapshotLoader {
+	return &snapshotLoader{
+		cropRepo:          cropRepo,
+		categoryRepo:      categoryRepo,
+		articleRepo:       articleRepo,
+		articleImagesRepo: articleImagesRepo,
+		pestRepo:          pestRepo,
+		pestImagesRepo:    pestImagesRepo,
+		mediaVariantsRepo: mediaVariantsRepo,
+		statusRepo:        statusRepo,
+	}
+}
+
+// Entity loads a crop, category, article or pest by its audit entity type.
+func (l *snapshotLoader) Entity(ctx context.Context, entityType string, id int) (any, error) {
+	switch entityType {
+	case model.EntityCrop:
+		return l.Crop(ctx, id)
+	case model.EntityCategory:
+		return l.Category(ctx, id)
+	case model.EntityArticle:
+		return l.Article(ctx, id)
+	case model.EntityPest:
+		return l.Pest(ctx, id)
+	default:
+		return nil, ErrInvalidArguments
+	}
+}
+
+func (l *snapshotLoader) Crop(ctx context.Context, id int) (*model.Crop, error) {
+	repoCrop, err := l.cropRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := l.status(ctx, repoCrop.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	crop := converter.ToCrop(repoCrop, status, authorRef(repoCrop.Author))
+	if repoCrop.ImgId != nil {
+		variants, err := l.variants(ctx, []int{*repoCrop.ImgId})
+		if err != nil {
+			return nil, err
+		}
+		crop.ImgVariants = variants[*repoCrop.ImgId]
+	}
+
+	return crop, nil
+}
+
+func (l *snapshotLoader) Category(ctx context.Context, id int) (*model.Category, error) {
+	repoCategory, err := l.categoryRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := l.status(ctx, repoCategory.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	category := converter.ToCategory(repoCategory, status, authorRef(repoCategory.Author))
+	if repoCategory.IconId != nil {
+		variants, err := l.variants(ctx, []int{*repoCategory.IconId})
+		if err != nil {
+			return nil, err
+		}
+		category.IconVariants = variants[*repoCategory.IconId]
+	}
+
+	return category, nil
+}
+
+func (l *snapshotLoader) Article(ctx context.Context, id int) (*model.Article, error) {
+	repoArticle, err := l.articleRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := l.status(ctx, repoArticle.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := l.articleImagesRepo.GetAll(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	mediaIds := make([]int, 0, len(images))
+	for _, image := range images {
+		if image.MediaId != nil {
+			mediaIds = append(mediaIds, *image.MediaId)
+		}
+	}
+	variants, err := l.variants(ctx, mediaIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToArticle(repoArticle, converter.ToArticleImages(images, variants), status, authorRef(repoArticle.Author)), nil
+}
+
+func (l *snapshotLoader) Pest(ctx context.Context, id int) (*model.Pest, error) {
+	repoPest, err := l.pestRepo.GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := l.status(ctx, repoPest.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := l.pestImagesRepo.GetAll(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToPest(repoPest, images, status, authorRef(repoPest.Author)), nil
+}
+
+func (l *snapshotLoader) status(ctx context.Context, statusId int) (string, error) {
+	repoStatus, err := l.statusRepo.GetById(ctx, statusId)
+	if err != nil {
+		return "", err
+	}
+	return repoStatus.Status, nil
+}
+
+func (l *snapshotLoader) variants(ctx context.Context, mediaIds []int) (map[int]model.ImageVariants, error) {
+	if len(mediaIds) == 0 {
+		return nil, nil
+	}
+
+	variants, err := l.mediaVariantsRepo.GetAllByMediaIds(ctx, mediaIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ToMediaImageVariants(variants), nil
+}
+
+func authorRef(id *int) *model.User {
+	if id == nil {
+		return nil
+	}
+	return &model.User{Id: *id}
+}
@@ -0,0 +1,262 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"github.com/nogavadu/articles-service/internal/domain/converter"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/apperr"
+	"github.com/nogavadu/articles-service/internal/repository"
+	cropRepo "github.com/nogavadu/articles-service/internal/repository/crop"
+	calendarRepo "github.com/nogavadu/articles-service/internal/repository/crop_calendar"
+	"github.com/nogavadu/articles-service/internal/service"
+	"github.com/nogavadu/platform_common/pkg/db"
+	"log/slog"
+)
+
+var (
+	ErrNotFound            = apperr.New(apperr.ErrNotFound, "calendar entry not found")
+	ErrCropNotFound        = apperr.New(apperr.ErrNotFound, "crop not found")
+	ErrInternalServerError = errors.New("internal server error")
+	ErrAccessDenied        = apperr.New(apperr.ErrAccessDenied, "access denied")
+	ErrUnpairedWeeks       = apperr.New(apperr.ErrInvalidArguments, "start_week and end_week must be set together")
+)
+
+type calendarService struct {
+	log *slog.Logger
+
+	calendarRepo repository.CropCalendarRepository
+	cropRepo     repository.CropRepository
+	statusRepo   repository.StatusRepository
+	workflow     service.StatusWorkflow
+	policy       service.AccessPolicy
+	audit        service.AuditService
+	txManager    db.TxManager
+}
+
+func New(
+	log *slog.Logger,
+	calendarRepo repository.CropCalendarRepository,
+	cropRepo repository.CropRepository,
+	statusRepo repository.StatusRepository,
+	workflow service.StatusWorkflow,
+	policy service.AccessPolicy,
+	audit service.AuditService,
+	txManager db.TxManager,
+) service.CalendarService {
+	return &calendarService{
+		log:          log,
+		calendarRepo: calendarRepo,
+		cropRepo:     cropRepo,
+		statusRepo:   statusRepo,
+		workflow:     workflow,
+		policy:       policy,
+		audit:        audit,
+		txManager:    txManager,
+	}
+}
+
+func (s *calendarService) Create(ctx context.Context, cropId int, info *model.CalendarEntryInfo) (int, error) {
+	const op = "calendarService.Create"
+	log := s.log.With(slog.String("op", op))
+
+	var id int
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if err := s.canModify(ctx, cropId); err != nil {
+			log.Error("failed to check crop access", slog.String("error", err.Error()))
+			return err
+		}
+
+		var err error
+		id, err = s.calendarRepo.Create(ctx, cropId, converter.ToRepoCalendarEntryInfo(info))
+		if err != nil {
+			log.Error("failed to create calendar entry", slog.String("error", err.Error()))
+			if errors.Is(err, calendarRepo.ErrInvalidArguments) {
+				return ErrCropNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		after, err := s.calendarRepo.GetById(ctx, cropId, id)
+		if err != nil {
+			log.Error("failed to get created calendar entry", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+
+		return s.audit.Record(ctx, model.EntityCalendarEntry, id, model.AuditActionCreate, nil, converter.ToCalendarEntry(after))
+	})
+
+	return id, err
+}
+
+func (s *calendarService) GetAll(ctx context.Context, cropId int) ([]model.CalendarEntry, error) {
+	const op = "calendarService.GetAll"
+	log := s.log.With(slog.String("op", op))
+
+	if _, err := s.cropRepo.GetById(ctx, cropId); err != nil {
+		log.Error("failed to get crop", slog.String("error", err.Error()))
+		if errors.Is(err, cropRepo.ErrNotFound) {
+			return nil, ErrCropNotFound
+		}
+
+		return nil, ErrInternalServerError
+	}
+
+	repoEntries, err := s.calendarRepo.GetAll(ctx, cropId)
+	if err != nil {
+		log.Error("failed to get calendar entries", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	entries := make([]model.CalendarEntry, 0, len(repoEntries))
+	for _, e := range repoEntries {
+		entries = append(entries, *converter.ToCalendarEntry(&e))
+	}
+
+	return entries, nil
+}
+
+func (s *calendarService) Update(ctx context.Context, cropId int, id int, input *model.UpdateCalendarEntryInput) error {
+	const op = "calendarService.Update"
+	log := s.log.With(slog.String("op", op))
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if err := s.canModify(ctx, cropId); err != nil {
+			log.Error("failed to check crop access", slog.String("error", err.Error()))
+			return err
+		}
+
+		before, err := s.calendarRepo.GetById(ctx, cropId, id)
+		if err != nil {
+			log.Error("failed to get calendar entry", slog.String("error", err.Error()))
+			if errors.Is(err, calendarRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+		if !weeksPaired(before.StartWeek, before.EndWeek, input) {
+			return ErrUnpairedWeeks
+		}
+
+		if err = s.calendarRepo.Update(ctx, cropId, id, converter.ToRepoCalendarUpdateInput(input)); err != nil {
+			log.Error("failed to update calendar entry", slog.String("error", err.Error()))
+			if errors.Is(err, calendarRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		after, err := s.calendarRepo.GetById(ctx, cropId, id)
+		if err != nil {
+			log.Error("failed to get updated calendar entry", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+
+		return s.audit.Record(
+			ctx, model.EntityCalendarEntry, id, model.AuditActionUpdate,
+			converter.ToCalendarEntry(before), converter.ToCalendarEntry(after),
+		)
+	})
+}
+
+func (s *calendarService) Delete(ctx context.Context, cropId int, id int) error {
+	const op = "calendarService.Delete"
+	log := s.log.With(slog.String("op", op))
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if err := s.canModify(ctx, cropId); err != nil {
+			log.Error("failed to check crop access", slog.String("error", err.Error()))
+			return err
+		}
+
+		before, err := s.calendarRepo.GetById(ctx, cropId, id)
+		if err != nil {
+			log.Error("failed to get calendar entry", slog.String("error", err.Error()))
+			if errors.Is(err, calendarRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		if err = s.calendarRepo.Delete(ctx, cropId, id); err != nil {
+			log.Error("failed to delete calendar entry", slog.String("error", err.Error()))
+			if errors.Is(err, calendarRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		return s.audit.Record(ctx, model.EntityCalendarEntry, id, model.AuditActionDelete, converter.ToCalendarEntry(before), nil)
+	})
+}
+
+// GetByZone returns what to do in the zone during the month, for published crops only.
+func (s *calendarService) GetByZone(ctx context.Context, params *model.CalendarZoneParams) ([]model.CalendarZoneEntry, error) {
+	const op = "calendarService.GetByZone"
+	log := s.log.With(slog.String("op", op))
+
+	statusId, err := s.workflow.ListStatus(ctx, nil, model.StatusPublished)
+	if err != nil {
+		log.Error("failed to resolve status", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	repoEntries, err := s.calendarRepo.GetByZone(ctx, converter.ToRepoCalendarZoneParams(params, statusId))
+	if err != nil {
+		log.Error("failed to get calendar entries", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	entries := make([]model.CalendarZoneEntry, 0, len(repoEntries))
+	for _, e := range repoEntries {
+		entries = append(entries, *converter.ToCalendarZoneEntry(&e))
+	}
+
+	return entries, nil
+}
+
+// weeksPaired checks the entry keeps either both weeks or none once input is applied, 0 removes a week.
+func weeksPaired(startWeek *int, endWeek *int, input *model.UpdateCalendarEntryInput) bool {
+	hasStart, hasEnd := startWeek != nil, endWeek != nil
+	if input.StartWeek != nil {
+		hasStart = *input.StartWeek != 0
+	}
+	if input.EndWeek != nil {
+		hasEnd = *input.EndWeek != 0
+	}
+
+	return hasStart == hasEnd
+}
+
+// canModify lets the calendar be edited by whoever may edit the crop.
+func (s *calendarService) canModify(ctx context.Context, cropId int) error {
+	crop, err := s.cropRepo.GetById(ctx, cropId)
+	if err != nil {
+		if errors.Is(err, cropRepo.ErrNotFound) {
+			return ErrCropNotFound
+		}
+
+		return ErrInternalServerError
+	}
+
+	status, err := s.statusRepo.GetById(ctx, crop.Status)
+	if err != nil {
+		return ErrInternalServerError
+	}
+
+	var author *model.User
+	if crop.Author != nil {
+		author = &model.User{Id: *crop.Author}
+	}
+
+	if err = s.policy.CanModify(ctx, author, status.Status); err != nil {
+		return ErrAccessDenied
+	}
+
+	return nil
+}
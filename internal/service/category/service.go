@@ -6,19 +6,30 @@ import (
 	authService "github.com/nogavadu/articles-service/internal/clients/auth-service/grpc"
 	"github.com/nogavadu/articles-service/internal/domain/converter"
 	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/apperr"
+	"github.com/nogavadu/articles-service/internal/lib/identity"
 	"github.com/nogavadu/articles-service/internal/repository"
 	categoryRepo "github.com/nogavadu/articles-service/internal/repository/category"
+	categoryRepoModel "github.com/nogavadu/articles-service/internal/repository/category/model"
+	cropCategoriesRepo "github.com/nogavadu/articles-service/internal/repository/crop_categories"
 	"github.com/nogavadu/articles-service/internal/service"
+	"github.com/nogavadu/articles-service/internal/service/hierarchy"
+	"github.com/nogavadu/articles-service/internal/service/workflow"
 	"github.com/nogavadu/platform_common/pkg/db"
 	"log/slog"
 )
 
 var (
-	ErrNotFound            = errors.New("category not found")
-	ErrAlreadyExists       = errors.New("category already exists")
-	ErrInvalidArguments    = errors.New("invalid article arguments")
+	ErrNotFound            = apperr.New(apperr.ErrNotFound, "category not found")
+	ErrAlreadyExists       = apperr.New(apperr.ErrAlreadyExists, "category already exists")
+	ErrInvalidArguments    = apperr.New(apperr.ErrInvalidArguments, "invalid category arguments")
 	ErrInternalServerError = errors.New("internal server error")
-	ErrAccessDenied        = errors.New("access denied")
+	ErrAccessDenied        = apperr.New(apperr.ErrAccessDenied, "access denied")
+	ErrIllegalTransition   = apperr.New(apperr.ErrIllegalTransition, "illegal status transition")
+	ErrCropNotFound        = apperr.New(apperr.ErrNotFound, "crop not found")
+	ErrParentNotFound      = apperr.New(apperr.ErrNotFound, "parent category not found")
+	ErrCycle               = apperr.New(apperr.ErrInvalidArguments, "category can't be moved under itself or its subcategories")
+	ErrMediaNotFound       = apperr.New(apperr.ErrNotFound, "media not found")
 )
 
 type categoryService struct {
@@ -26,7 +37,12 @@ type categoryService struct {
 
 	categoryRepo       repository.CategoryRepository
 	cropCategoriesRepo repository.CropCategoriesRepository
+	mediaVariantsRepo  repository.MediaVariantsRepository
 	statusRepo         repository.StatusRepository
+	workflow           service.StatusWorkflow
+	policy             service.AccessPolicy
+	audit              service.AuditService
+	snapshots          service.SnapshotLoader
 	txManager          db.TxManager
 
 	accessClient *authService.AccessServiceClient
@@ -38,7 +54,12 @@ func New(
 	log *slog.Logger,
 	categoryRepo repository.CategoryRepository,
 	cropCategoriesRepo repository.CropCategoriesRepository,
+	mediaVariantsRepo repository.MediaVariantsRepository,
 	statusRepo repository.StatusRepository,
+	workflow service.StatusWorkflow,
+	policy service.AccessPolicy,
+	audit service.AuditService,
+	snapshots service.SnapshotLoader,
 	txManager db.TxManager,
 	accessClient *authService.AccessServiceClient,
 	authClient *authService.AuthServiceClient,
@@ -48,7 +69,12 @@ func New(
 		log:                log,
 		categoryRepo:       categoryRepo,
 		cropCategoriesRepo: cropCategoriesRepo,
+		mediaVariantsRepo:  mediaVariantsRepo,
 		statusRepo:         statusRepo,
+		workflow:           workflow,
+		policy:             policy,
+		audit:              audit,
+		snapshots:          snapshots,
 		txManager:          txManager,
 		accessClient:       accessClient,
 		authClient:         authClient,
@@ -58,13 +84,18 @@ func New(
 
 func (s *categoryService) Create(
 	ctx context.Context,
-	userId int,
 	categoryInfo *model.CategoryInfo,
 	params *model.CategoryCreateParams,
 ) (int, error) {
 	const op = "category.Create"
 	log := s.log.With(slog.String("op", op))
 
+	userId, ok := identity.UserId(ctx)
+	if !ok {
+		log.Error("caller is not authenticated")
+		return 0, ErrAccessDenied
+	}
+
 	var id int
 	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
 		var errTx error
@@ -74,32 +105,26 @@ func (s *categoryService) Create(
 			}
 		}()
 
-		token, err := s.authClient.AccessToken(ctx)
+		statusId, err := s.workflow.InitialStatus(ctx, categoryInfo.Status)
 		if err != nil {
-			log.Error("failed to get access token", slog.String("error", err.Error()))
-			return ErrAccessDenied
+			log.Error("failed to resolve initial status", slog.String("error", err.Error()))
+			return workflow.MapError(err)
 		}
 
-		status, err := s.statusRepo.GetByStatus(ctx, categoryInfo.Status)
-		if err != nil {
-			log.Error("failed to get status", slog.String("error", err.Error()))
-		}
-
-		accessLevel := authService.ModeratorAccessLevel
-		if status != nil && status.Id == 2 {
-			accessLevel = authService.UserAccessLevel
-		}
-
-		err = s.accessClient.Check(ctx, token, accessLevel)
-		if err != nil {
-			log.Error("access check failed", slog.String("error", err.Error()))
-			return ErrAccessDenied
+		if categoryInfo.ParentId != nil {
+			if _, errTx = s.categoryRepo.GetById(ctx, *categoryInfo.ParentId); errTx != nil {
+				if errors.Is(errTx, categoryRepo.ErrNotFound) {
+					return ErrParentNotFound
+				}
+				return ErrInternalServerError
+			}
 		}
 
-		id, errTx = s.categoryRepo.Create(ctx, converter.ToRepoCategoryInfo(categoryInfo, status.Id, userId))
+		id, errTx = s.categoryRepo.Create(ctx, converter.ToRepoCategoryInfo(categoryInfo, statusId, userId))
 		if errTx != nil {
+			// The parent is checked above, so the only other reference is the icon.
 			if errors.Is(errTx, categoryRepo.ErrInvalidArguments) {
-				return ErrInvalidArguments
+				return ErrMediaNotFound
 			}
 			if errors.Is(errTx, categoryRepo.ErrAlreadyExists) {
 				return ErrAlreadyExists
@@ -111,10 +136,30 @@ func (s *categoryService) Create(
 		if params.CropId != nil {
 			errTx = s.cropCategoriesRepo.Create(ctx, *params.CropId, id)
 			if errTx != nil {
+				if errors.Is(errTx, cropCategoriesRepo.ErrNotFound) {
+					return ErrCropNotFound
+				}
 				return ErrInternalServerError
 			}
 		}
 
+		after, errTx := s.snapshots.Category(ctx, id)
+		if errTx != nil {
+			return ErrInternalServerError
+		}
+		if errTx = s.audit.Record(ctx, model.EntityCategory, id, model.AuditActionCreate, nil, after); errTx != nil {
+			return errTx
+		}
+		if params.CropId != nil {
+			errTx = s.audit.Record(ctx, model.EntityCrop, *params.CropId, model.AuditActionAddRelation, nil, &model.AuditRelation{
+				EntityType: model.EntityCategory,
+				EntityId:   id,
+			})
+			if errTx != nil {
+				return errTx
+			}
+		}
+
 		return nil
 	})
 
@@ -125,17 +170,10 @@ func (s *categoryService) GetAll(ctx context.Context, params *model.CategoryGetA
 	const op = "category.GetAll"
 	log := s.log.With(slog.String("op", op))
 
-	var statusId int
-	if params.Status != nil {
-		status, err := s.statusRepo.GetByStatus(ctx, *params.Status)
-		if err != nil {
-			log.Error("failed to get status", slog.String("error", err.Error()))
-		}
-		if status != nil {
-			statusId = status.Id
-		}
-	} else {
-		statusId = 2
+	statusId, err := s.workflow.ListStatus(ctx, params.Status, model.StatusReview)
+	if err != nil {
+		log.Error("failed to resolve status", slog.String("error", err.Error()))
+		return nil, workflow.MapError(err)
 	}
 
 	repoCategories, err := s.categoryRepo.GetAll(ctx, converter.ToRepoCategoryGetAllParams(params, statusId))
@@ -144,27 +182,45 @@ func (s *categoryService) GetAll(ctx context.Context, params *model.CategoryGetA
 		return nil, ErrInternalServerError
 	}
 
-	categories := make([]model.Category, 0, len(repoCategories))
+	statuses, err := s.statusRepo.GetMap(ctx)
+	if err != nil {
+		log.Error("failed to get statuses", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	authorIds := make([]int, 0, len(repoCategories))
 	for _, c := range repoCategories {
-		repoStatus, err := s.statusRepo.GetById(ctx, c.Status)
-		if err != nil {
-			log.Error("failed to get status", slog.String("error", err.Error()))
-			continue
+		if c.Author != nil {
+			authorIds = append(authorIds, *c.Author)
 		}
+	}
 
+	authors, err := s.userClient.GetByIds(ctx, authorIds)
+	if err != nil {
+		log.Error("failed to get authors", slog.String("error", err.Error()))
+	}
+
+	variants, err := s.iconVariants(ctx, repoCategories)
+	if err != nil {
+		log.Error("failed to get icon variants", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	categories := make([]model.Category, 0, len(repoCategories))
+	for _, c := range repoCategories {
 		var author *model.User
 		if c.Author != nil {
-			user, errTx := s.userClient.GetById(ctx, *c.Author)
-			if errTx != nil {
-				continue
-			}
-			author = user
+			author = authors[*c.Author]
 		}
 
-		categories = append(categories, *converter.ToCategory(&c, repoStatus.Status, author))
+		category := converter.ToCategory(&c, statuses[c.Status], author)
+		if c.IconId != nil {
+			category.IconVariants = variants[*c.IconId]
+		}
+		categories = append(categories, *category)
 	}
 
-	return categories, nil
+	return buildTree(categories), nil
 }
 
 func (s *categoryService) GetById(ctx context.Context, id int) (*model.Category, error) {
@@ -182,7 +238,6 @@ func (s *categoryService) GetById(ctx context.Context, id int) (*model.Category,
 
 		repoCategory, errTx := s.categoryRepo.GetById(ctx, id)
 		if errTx != nil {
-			log.Error("failed to get category", slog.String("error", errTx.Error()))
 			if errors.Is(errTx, categoryRepo.ErrNotFound) {
 				return ErrNotFound
 			}
@@ -195,19 +250,26 @@ func (s *categoryService) GetById(ctx context.Context, id int) (*model.Category,
 
 		repoStatus, errTx := s.statusRepo.GetById(ctx, repoCategory.Status)
 		if errTx != nil {
-			return ErrNotFound
+			return ErrInternalServerError
 		}
 
 		var author *model.User
 		if repoCategory.Author != nil {
-			user, errTx := s.userClient.GetById(ctx, *repoCategory.Author)
+			author, errTx = s.userClient.GetById(ctx, *repoCategory.Author)
 			if errTx != nil {
-				return ErrNotFound
+				return ErrInternalServerError
 			}
-			author = user
+		}
+
+		variants, errTx := s.iconVariants(ctx, []categoryRepoModel.Category{*repoCategory})
+		if errTx != nil {
+			return ErrInternalServerError
 		}
 
 		category = converter.ToCategory(repoCategory, repoStatus.Status, author)
+		if repoCategory.IconId != nil {
+			category.IconVariants = variants[*repoCategory.IconId]
+		}
 
 		return nil
 	})
@@ -219,30 +281,159 @@ func (s *categoryService) Update(ctx context.Context, id int, input *model.Updat
 	const op = "category.Update"
 	log := s.log.With(slog.String("op", op))
 
-	if input.Status != nil {
-		status, _ := s.statusRepo.GetByStatus(ctx, *input.Status)
-		if err := s.categoryRepo.Update(ctx, id, converter.ToRepoCategoryUpdateInput(input, &status.Id)); err != nil {
-			log.Error("failed to update category", slog.String("error", err.Error()))
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		before, err := s.snapshots.Category(ctx, id)
+		if err != nil {
+			log.Error("failed to get category", slog.String("error", err.Error()))
+			if errors.Is(err, categoryRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
 			return ErrInternalServerError
 		}
-	} else {
-		if err := s.categoryRepo.Update(ctx, id, converter.ToRepoCategoryUpdateInput(input, nil)); err != nil {
+		if err = s.policy.CanModify(ctx, before.Author, before.Status); err != nil {
+			log.Error("access check failed", slog.String("error", err.Error()))
+			return ErrAccessDenied
+		}
+
+		var statusId *int
+		if input.Status != nil {
+			newStatusId, err := s.workflow.Transition(ctx, before.Status, *input.Status)
+			if err != nil {
+				log.Error("failed to change category status", slog.String("error", err.Error()))
+				return workflow.MapError(err)
+			}
+			statusId = &newStatusId
+		}
+
+		if err = s.categoryRepo.Update(ctx, id, converter.ToRepoCategoryUpdateInput(input, statusId)); err != nil {
 			log.Error("failed to update category", slog.String("error", err.Error()))
+			if errors.Is(err, categoryRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+			if errors.Is(err, categoryRepo.ErrInvalidArguments) {
+				return ErrMediaNotFound
+			}
+
 			return ErrInternalServerError
 		}
-	}
 
-	return nil
+		after, err := s.snapshots.Category(ctx, id)
+		if err != nil {
+			log.Error("failed to get updated category", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+
+		return s.audit.Record(ctx, model.EntityCategory, id, model.AuditActionUpdate, before, after)
+	})
+}
+
+func (s *categoryService) Move(ctx context.Context, id int, input *model.CategoryMoveInput) error {
+	const op = "category.Move"
+	log := s.log.With(slog.String("op", op))
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		before, err := s.snapshots.Category(ctx, id)
+		if err != nil {
+			log.Error("failed to get category", slog.String("error", err.Error()))
+			if errors.Is(err, categoryRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+		if err = s.policy.CanModify(ctx, before.Author, before.Status); err != nil {
+			log.Error("access check failed", slog.String("error", err.Error()))
+			return ErrAccessDenied
+		}
+
+		if input.ParentId != nil {
+			if _, err = s.categoryRepo.GetById(ctx, *input.ParentId); err != nil {
+				log.Error("failed to get parent category", slog.String("error", err.Error()))
+				if errors.Is(err, categoryRepo.ErrNotFound) {
+					return ErrParentNotFound
+				}
+
+				return ErrInternalServerError
+			}
+
+			cycle, err := hierarchy.CreatesCycle(ctx, s.categoryRepo, id, *input.ParentId)
+			if err != nil {
+				log.Error("failed to check parent category ancestors", slog.String("error", err.Error()))
+				return ErrInternalServerError
+			}
+			if cycle {
+				return ErrCycle
+			}
+		}
+
+		if err = s.categoryRepo.Move(ctx, id, input.ParentId, input.Position); err != nil {
+			log.Error("failed to move category", slog.String("error", err.Error()))
+			if errors.Is(err, categoryRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+			if errors.Is(err, categoryRepo.ErrInvalidArguments) {
+				return ErrParentNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		after, err := s.snapshots.Category(ctx, id)
+		if err != nil {
+			log.Error("failed to get moved category", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+
+		return s.audit.Record(ctx, model.EntityCategory, id, model.AuditActionUpdate, before, after)
+	})
 }
 
 func (s *categoryService) Delete(ctx context.Context, id int) error {
 	const op = "category.Delete"
 	log := s.log.With(slog.String("op", op))
 
-	if err := s.categoryRepo.Delete(ctx, id); err != nil {
-		log.Error("failed to delete category", slog.String("error", err.Error()))
-		return ErrInternalServerError
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		before, err := s.snapshots.Category(ctx, id)
+		if err != nil {
+			log.Error("failed to get category", slog.String("error", err.Error()))
+			if errors.Is(err, categoryRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+		if err = s.policy.CanModify(ctx, before.Author, before.Status); err != nil {
+			log.Error("access check failed", slog.String("error", err.Error()))
+			return ErrAccessDenied
+		}
+
+		if err = s.categoryRepo.Delete(ctx, id); err != nil {
+			log.Error("failed to delete category", slog.String("error", err.Error()))
+			if errors.Is(err, categoryRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		return s.audit.Record(ctx, model.EntityCategory, id, model.AuditActionDelete, before, nil)
+	})
+}
+
+// iconVariants loads the variants of the category icons at once, keyed by media id.
+func (s *categoryService) iconVariants(ctx context.Context, categories []categoryRepoModel.Category) (map[int]model.ImageVariants, error) {
+	mediaIds := make([]int, 0, len(categories))
+	for _, category := range categories {
+		if category.IconId != nil {
+			mediaIds = append(mediaIds, *category.IconId)
+		}
+	}
+
+	variants, err := s.mediaVariantsRepo.GetAllByMediaIds(ctx, mediaIds)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return converter.ToMediaImageVariants(variants), nil
 }
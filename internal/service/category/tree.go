@@ -0,0 +1,32 @@
+package category
+
+import "github.com/nogavadu/articles-service/internal/domain/model"
+
+// buildTree nests the categories under their parents, keeping the order they were listed in.
+// A category whose parent isn't in the list, e.g. not linked to the requested crop, becomes a root.
+func buildTree(categories []model.Category) []model.Category {
+	listed := make(map[int]bool, len(categories))
+	for _, c := range categories {
+		listed[c.ID] = true
+	}
+
+	children := make(map[int][]model.Category)
+	roots := make([]model.Category, 0)
+	for _, c := range categories {
+		if c.ParentId != nil && listed[*c.ParentId] {
+			children[*c.ParentId] = append(children[*c.ParentId], c)
+			continue
+		}
+		roots = append(roots, c)
+	}
+
+	var attach func(nodes []model.Category) []model.Category
+	attach = func(nodes []model.Category) []model.Category {
+		for i := range nodes {
+			nodes[i].Children = attach(children[nodes[i].ID])
+		}
+		return nodes
+	}
+
+	return attach(roots)
+}
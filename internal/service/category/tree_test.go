@@ -0,0 +1,72 @@
+package category
+
+import (
+	"fmt"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"strings"
+	"testing"
+)
+
+func category(id int, parentId int) model.Category {
+	c := model.Category{ID: id}
+	if parentId != 0 {
+		c.ParentId = &parentId
+	}
+	return c
+}
+
+// shape prints the tree as "1(2 3(4)) 5" so the expectations stay readable.
+func shape(nodes []model.Category) string {
+	parts := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		if len(n.Children) == 0 {
+			parts = append(parts, fmt.Sprint(n.ID))
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%d(%s)", n.ID, shape(n.Children)))
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestBuildTree(t *testing.T) {
+	tests := []struct {
+		name       string
+		categories []model.Category
+		want       string
+	}{
+		{name: "empty", categories: nil, want: ""},
+		{
+			name:       "flat list keeps order",
+			categories: []model.Category{category(3, 0), category(1, 0), category(2, 0)},
+			want:       "3 1 2",
+		},
+		{
+			name:       "nested",
+			categories: []model.Category{category(1, 0), category(2, 1), category(3, 2), category(4, 0)},
+			want:       "1(2(3)) 4",
+		},
+		{
+			name:       "children keep their order",
+			categories: []model.Category{category(1, 0), category(5, 1), category(2, 1), category(4, 1)},
+			want:       "1(5 2 4)",
+		},
+		{
+			name:       "child listed before its parent",
+			categories: []model.Category{category(2, 1), category(1, 0)},
+			want:       "1(2)",
+		},
+		{
+			name:       "orphan becomes a root",
+			categories: []model.Category{category(1, 0), category(3, 2), category(4, 3)},
+			want:       "1 3(4)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := shape(buildTree(tt.categories)); got != tt.want {
+				t.Errorf("buildTree() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package companion
+
+import (
+	"context"
+	"errors"
+	"github.com/nogavadu/articles-service/internal/domain/converter"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/apperr"
+	"github.com/nogavadu/articles-service/internal/repository"
+	cropRepo "github.com/nogavadu/articles-service/internal/repository/crop"
+	companionRepo "github.com/nogavadu/articles-service/internal/repository/crop_companions"
+	"github.com/nogavadu/articles-service/internal/service"
+	"github.com/nogavadu/platform_common/pkg/db"
+	"log/slog"
+	"slices"
+)
+
+var (
+	ErrNotFound            = apperr.New(apperr.ErrNotFound, "crop companion not found")
+	ErrCropNotFound        = apperr.New(apperr.ErrNotFound, "crop not found")
+	ErrSelfCompanion       = apperr.New(apperr.ErrInvalidArguments, "crop can't be its own companion")
+	ErrInternalServerError = errors.New("internal server error")
+	ErrAccessDenied        = apperr.New(apperr.ErrAccessDenied, "access denied")
+)
+
+type companionService struct {
+	log *slog.Logger
+
+	companionRepo repository.CropCompanionsRepository
+	cropRepo      repository.CropRepository
+	statusRepo    repository.StatusRepository
+	policy        service.AccessPolicy
+	audit         service.AuditService
+	txManager     db.TxManager
+}
+
+func New(
+	log *slog.Logger,
+	companionRepo repository.CropCompanionsRepository,
+	cropRepo repository.CropRepository,
+	statusRepo repository.StatusRepository,
+	policy service.AccessPolicy,
+	audit service.AuditService,
+	txManager db.TxManager,
+) service.CompanionService {
+	return &companionService{
+		log:           log,
+		companionRepo: companionRepo,
+		cropRepo:      cropRepo,
+		statusRepo:    statusRepo,
+		policy:        policy,
+		audit:         audit,
+		txManager:     txManager,
+	}
+}
+
+func (s *companionService) GetAll(ctx context.Context, cropId int) ([]model.CropCompanion, error) {
+	const op = "companionService.GetAll"
+	log := s.log.With(slog.String("op", op))
+
+	if _, err := s.cropRepo.GetById(ctx, cropId); err != nil {
+		log.Error("failed to get crop", slog.String("error", err.Error()))
+		if errors.Is(err, cropRepo.ErrNotFound) {
+			return nil, ErrCropNotFound
+		}
+
+		return nil, ErrInternalServerError
+	}
+
+	repoCompanions, err := s.companionRepo.GetAll(ctx, cropId)
+	if err != nil {
+		log.Error("failed to get crop companions", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	companions := make([]model.CropCompanion, 0, len(repoCompanions))
+	for _, c := range repoCompanions {
+		companions = append(companions, *converter.ToCropCompanion(&c))
+	}
+
+	return companions, nil
+}
+
+// Set creates the relation between the crops or replaces its type and note.
+// The relation shows up on both crops, so the caller must be allowed to modify both.
+func (s *companionService) Set(ctx context.Context, cropId int, companionId int, info *model.CropCompanionInfo) error {
+	const op = "companionService.Set"
+	log := s.log.With(slog.String("op", op))
+
+	if cropId == companionId {
+		return ErrSelfCompanion
+	}
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if err := s.canModifyPair(ctx, cropId, companionId); err != nil {
+			log.Error("failed to check crop access", slog.String("error", err.Error()))
+			return err
+		}
+
+		var before *model.CropCompanionInfo
+		repoBefore, err := s.companionRepo.Get(ctx, cropId, companionId)
+		switch {
+		case err == nil:
+			before = converter.ToCropCompanionInfo(repoBefore)
+		case !errors.Is(err, companionRepo.ErrNotFound):
+			log.Error("failed to get crop companion", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+
+		if err = s.companionRepo.Set(ctx, cropId, companionId, converter.ToRepoCompanionInfo(info)); err != nil {
+			log.Error("failed to set crop companion", slog.String("error", err.Error()))
+			if errors.Is(err, companionRepo.ErrInvalidArguments) {
+				return ErrCropNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		action := model.AuditActionAddRelation
+		if before != nil {
+			action = model.AuditActionUpdate
+		}
+
+		return s.record(ctx, cropId, companionId, action, before, info)
+	})
+}
+
+func (s *companionService) Delete(ctx context.Context, cropId int, companionId int) error {
+	const op = "companionService.Delete"
+	log := s.log.With(slog.String("op", op))
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if err := s.canModifyPair(ctx, cropId, companionId); err != nil {
+			log.Error("failed to check crop access", slog.String("error", err.Error()))
+			return err
+		}
+
+		repoBefore, err := s.companionRepo.Get(ctx, cropId, companionId)
+		if err != nil {
+			log.Error("failed to get crop companion", slog.String("error", err.Error()))
+			if errors.Is(err, companionRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		if err = s.companionRepo.Delete(ctx, cropId, companionId); err != nil {
+			log.Error("failed to delete crop companion", slog.String("error", err.Error()))
+			if errors.Is(err, companionRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		return s.record(ctx, cropId, companionId, model.AuditActionRemoveRelation, converter.ToCropCompanionInfo(repoBefore), nil)
+	})
+}
+
+// CheckBed returns every antagonist pair among the crops planned in the same bed.
+func (s *companionService) CheckBed(ctx context.Context, input *model.BedCheckInput) ([]model.BedConflict, error) {
+	const op = "companionService.CheckBed"
+	log := s.log.With(slog.String("op", op))
+
+	cropIds := slices.Clone(input.CropIds)
+	slices.Sort(cropIds)
+	cropIds = slices.Compact(cropIds)
+
+	repoConflicts, err := s.companionRepo.GetConflicts(ctx, cropIds)
+	if err != nil {
+		log.Error("failed to get crop conflicts", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	conflicts := make([]model.BedConflict, 0, len(repoConflicts))
+	for _, c := range repoConflicts {
+		conflicts = append(conflicts, *converter.ToBedConflict(&c))
+	}
+
+	return conflicts, nil
+}
+
+// record audits the change on both crops, each entry names the other crop as the companion.
+func (s *companionService) record(
+	ctx context.Context,
+	cropId int,
+	companionId int,
+	action string,
+	before, after *model.CropCompanionInfo,
+) error {
+	for _, pair := range [][2]int{{cropId, companionId}, {companionId, cropId}} {
+		if err := s.audit.Record(
+			ctx, model.EntityCrop, pair[0], action,
+			cropCompanion(pair[1], before), cropCompanion(pair[1], after),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func cropCompanion(companionId int, info *model.CropCompanionInfo) *model.CropCompanion {
+	if info == nil {
+		return nil
+	}
+
+	return &model.CropCompanion{
+		CompanionId:       companionId,
+		CropCompanionInfo: *info,
+	}
+}
+
+// canModifyPair requires the right to modify both crops of the relation.
+func (s *companionService) canModifyPair(ctx context.Context, cropId int, companionId int) error {
+	if err := s.canModify(ctx, cropId); err != nil {
+		return err
+	}
+
+	return s.canModify(ctx, companionId)
+}
+
+// canModify lets the companions be edited by whoever may edit the crop.
+func (s *companionService) canModify(ctx context.Context, cropId int) error {
+	crop, err := s.cropRepo.GetById(ctx, cropId)
+	if err != nil {
+		if errors.Is(err, cropRepo.ErrNotFound) {
+			return ErrCropNotFound
+		}
+
+		return ErrInternalServerError
+	}
+
+	status, err := s.statusRepo.GetById(ctx, crop.Status)
+	if err != nil {
+		return ErrInternalServerError
+	}
+
+	var author *model.User
+	if crop.Author != nil {
+		author = &model.User{Id: *crop.Author}
+	}
+
+	if err = s.policy.CanModify(ctx, author, status.Status); err != nil {
+		return ErrAccessDenied
+	}
+
+	return nil
+}
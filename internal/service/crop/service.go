@@ -7,19 +7,33 @@ import (
 	authService "github.com/nogavadu/articles-service/internal/clients/auth-service/grpc"
 	"github.com/nogavadu/articles-service/internal/domain/converter"
 	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/apperr"
+	"github.com/nogavadu/articles-service/internal/lib/identity"
 	"github.com/nogavadu/articles-service/internal/repository"
 	cropRepo "github.com/nogavadu/articles-service/internal/repository/crop"
+	cropRepoModel "github.com/nogavadu/articles-service/internal/repository/crop/model"
+	cropCategoriesRepo "github.com/nogavadu/articles-service/internal/repository/crop_categories"
 	"github.com/nogavadu/articles-service/internal/service"
+	"github.com/nogavadu/articles-service/internal/service/hierarchy"
+	"github.com/nogavadu/articles-service/internal/service/workflow"
 	"github.com/nogavadu/platform_common/pkg/db"
 	"log/slog"
 )
 
 var (
-	ErrNotFound            = errors.New("crop not found")
-	ErrAlreadyExists       = errors.New("crop already exists")
-	ErrInvalidArguments    = errors.New("invalid article arguments")
+	ErrNotFound            = apperr.New(apperr.ErrNotFound, "crop not found")
+	ErrAlreadyExists       = apperr.New(apperr.ErrAlreadyExists, "crop already exists")
+	ErrInvalidArguments    = apperr.New(apperr.ErrInvalidArguments, "invalid crop arguments")
 	ErrInternalServerError = errors.New("internal server error")
-	ErrAccessDenied        = errors.New("access denied")
+	ErrAccessDenied        = apperr.New(apperr.ErrAccessDenied, "access denied")
+	ErrIllegalTransition   = apperr.New(apperr.ErrIllegalTransition, "illegal status transition")
+	ErrCategoryNotFound    = apperr.New(apperr.ErrNotFound, "category not found")
+	ErrRelationNotFound    = apperr.New(apperr.ErrNotFound, "category is not linked to the crop")
+	ErrRelationExists      = apperr.New(apperr.ErrAlreadyExists, "category is already linked to the crop")
+	ErrRelationInUse       = apperr.New(apperr.ErrConflict, "crop has articles in this category")
+	ErrParentNotFound      = apperr.New(apperr.ErrNotFound, "parent crop not found")
+	ErrCycle               = apperr.New(apperr.ErrInvalidArguments, "crop can't be a variety of itself or its varieties")
+	ErrMediaNotFound       = apperr.New(apperr.ErrNotFound, "media not found")
 )
 
 type cropService struct {
@@ -27,7 +41,12 @@ type cropService struct {
 
 	cropRepo           repository.CropRepository
 	cropCategoriesRepo repository.CropCategoriesRepository
+	mediaVariantsRepo  repository.MediaVariantsRepository
 	statusRepo         repository.StatusRepository
+	workflow           service.StatusWorkflow
+	policy             service.AccessPolicy
+	audit              service.AuditService
+	snapshots          service.SnapshotLoader
 	txManager          db.TxManager
 
 	accessClient *authService.AccessServiceClient
@@ -39,7 +58,12 @@ func New(
 	log *slog.Logger,
 	cropRepository repository.CropRepository,
 	cropCategoriesRepo repository.CropCategoriesRepository,
+	mediaVariantsRepo repository.MediaVariantsRepository,
 	statusRepo repository.StatusRepository,
+	workflow service.StatusWorkflow,
+	policy service.AccessPolicy,
+	audit service.AuditService,
+	snapshots service.SnapshotLoader,
 	txManager db.TxManager,
 	accessClient *authService.AccessServiceClient,
 	authClient *authService.AuthServiceClient,
@@ -49,7 +73,12 @@ func New(
 		log:                log,
 		cropRepo:           cropRepository,
 		cropCategoriesRepo: cropCategoriesRepo,
+		mediaVariantsRepo:  mediaVariantsRepo,
 		statusRepo:         statusRepo,
+		workflow:           workflow,
+		policy:             policy,
+		audit:              audit,
+		snapshots:          snapshots,
 		txManager:          txManager,
 		accessClient:       accessClient,
 		authClient:         authClient,
@@ -57,40 +86,64 @@ func New(
 	}
 }
 
-func (s *cropService) Create(ctx context.Context, userId int, cropInfo *model.CropInfo) (int, error) {
+func (s *cropService) Create(ctx context.Context, cropInfo *model.CropInfo) (int, error) {
 	const op = "cropService.Create"
 	log := s.log.With(slog.String("op", op))
 
-	token, err := s.authClient.AccessToken(ctx)
-	if err != nil {
-		log.Error("failed to get access token", slog.String("error", err.Error()))
+	userId, ok := identity.UserId(ctx)
+	if !ok {
+		log.Error("caller is not authenticated")
 		return 0, ErrAccessDenied
 	}
 
-	status, err := s.statusRepo.GetByStatus(ctx, cropInfo.Status)
+	statusId, err := s.workflow.InitialStatus(ctx, cropInfo.Status)
 	if err != nil {
-		log.Error("failed to get status", slog.String("error", err.Error()))
-	}
-	accessLevel := authService.ModeratorAccessLevel
-	if status != nil && status.Id == 2 {
-		accessLevel = authService.UserAccessLevel
+		log.Error("failed to resolve initial status", slog.String("error", err.Error()))
+		return 0, workflow.MapError(err)
 	}
 
-	err = s.accessClient.Check(ctx, token, accessLevel)
-	if err != nil {
-		log.Error("access check failed", slog.String("error", err.Error()))
-		return 0, ErrAccessDenied
-	}
+	var cropID int
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if cropInfo.ParentId != nil {
+			if _, err := s.cropRepo.GetById(ctx, *cropInfo.ParentId); err != nil {
+				log.Error("failed to get parent crop", slog.String("error", err.Error()))
+				if errors.Is(err, cropRepo.ErrNotFound) {
+					return ErrParentNotFound
+				}
 
-	cropID, err := s.cropRepo.Create(ctx, converter.ToRepoCropInfo(cropInfo, status.Id, userId))
-	if err != nil {
-		log.Error("failed to create crop", slog.String("error", err.Error()))
+				return ErrInternalServerError
+			}
+		}
 
-		if errors.Is(err, cropRepo.ErrAlreadyExists) {
-			return 0, ErrAlreadyExists
+		id, err := s.cropRepo.Create(ctx, converter.ToRepoCropInfo(cropInfo, statusId, userId))
+		if err != nil {
+			log.Error("failed to create crop", slog.String("error", err.Error()))
+
+			if errors.Is(err, cropRepo.ErrAlreadyExists) {
+				return ErrAlreadyExists
+			}
+			// The parent is checked above, so the only other reference is the image.
+			if errors.Is(err, cropRepo.ErrInvalidArguments) {
+				return ErrMediaNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		after, err := s.snapshots.Crop(ctx, id)
+		if err != nil {
+			log.Error("failed to get created crop", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+		if err = s.audit.Record(ctx, model.EntityCrop, id, model.AuditActionCreate, nil, after); err != nil {
+			return err
 		}
 
-		return 0, ErrInternalServerError
+		cropID = id
+		return nil
+	})
+	if err != nil {
+		return 0, err
 	}
 
 	return cropID, nil
@@ -100,17 +153,10 @@ func (s *cropService) GetAll(ctx context.Context, params *model.CropGetAllParams
 	const op = "cropService.GetAll"
 	log := s.log.With(slog.String("op", op))
 
-	var statusId int
-	if params.Status != nil {
-		status, err := s.statusRepo.GetByStatus(ctx, *params.Status)
-		if err != nil {
-			log.Error("failed to get status", slog.String("error", err.Error()))
-		}
-		if status != nil {
-			statusId = status.Id
-		}
-	} else {
-		statusId = 1
+	statusId, err := s.workflow.ListStatus(ctx, params.Status, model.StatusPublished)
+	if err != nil {
+		log.Error("failed to resolve status", slog.String("error", err.Error()))
+		return nil, workflow.MapError(err)
 	}
 
 	repoCrops, err := s.cropRepo.GetAll(ctx, statusId)
@@ -119,24 +165,42 @@ func (s *cropService) GetAll(ctx context.Context, params *model.CropGetAllParams
 		return nil, ErrInternalServerError
 	}
 
-	crops := make([]model.Crop, 0, len(repoCrops))
+	statuses, err := s.statusRepo.GetMap(ctx)
+	if err != nil {
+		log.Error("failed to get statuses", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	authorIds := make([]int, 0, len(repoCrops))
 	for _, repoCrop := range repoCrops {
-		repoStatus, err := s.statusRepo.GetById(ctx, repoCrop.Status)
-		if err != nil {
-			log.Error("failed to get status", slog.String("error", err.Error()))
-			continue
+		if repoCrop.Author != nil {
+			authorIds = append(authorIds, *repoCrop.Author)
 		}
+	}
+
+	authors, err := s.userClient.GetByIds(ctx, authorIds)
+	if err != nil {
+		log.Error("failed to get authors", slog.String("error", err.Error()))
+	}
+
+	variants, err := s.imgVariants(ctx, repoCrops)
+	if err != nil {
+		log.Error("failed to get image variants", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
 
+	crops := make([]model.Crop, 0, len(repoCrops))
+	for _, repoCrop := range repoCrops {
 		var author *model.User
 		if repoCrop.Author != nil {
-			user, errTx := s.userClient.GetById(ctx, *repoCrop.Author)
-			if errTx != nil {
-				continue
-			}
-			author = user
+			author = authors[*repoCrop.Author]
 		}
 
-		crops = append(crops, *converter.ToCrop(&repoCrop, repoStatus.Status, author))
+		crop := converter.ToCrop(&repoCrop, statuses[repoCrop.Status], author)
+		if repoCrop.ImgId != nil {
+			crop.ImgVariants = variants[*repoCrop.ImgId]
+		}
+		crops = append(crops, *crop)
 	}
 
 	return crops, nil
@@ -157,6 +221,9 @@ func (s *cropService) GetById(ctx context.Context, id int) (*model.Crop, error)
 
 		repoCrop, errTx := s.cropRepo.GetById(ctx, id)
 		if errTx != nil {
+			if errors.Is(errTx, cropRepo.ErrNotFound) {
+				return ErrNotFound
+			}
 			return ErrInternalServerError
 		}
 
@@ -173,7 +240,15 @@ func (s *cropService) GetById(ctx context.Context, id int) (*model.Crop, error)
 			}
 		}
 
+		variants, errTx := s.imgVariants(ctx, []cropRepoModel.Crop{*repoCrop})
+		if errTx != nil {
+			return ErrInternalServerError
+		}
+
 		crop = converter.ToCrop(repoCrop, repoStatus.Status, author)
+		if repoCrop.ImgId != nil {
+			crop.ImgVariants = variants[*repoCrop.ImgId]
+		}
 
 		return nil
 	})
@@ -185,98 +260,245 @@ func (s *cropService) Update(ctx context.Context, id int, input *model.UpdateCro
 	const op = "cropService.Update"
 	log := s.log.With(slog.String("op", op))
 
-	token, err := s.authClient.AccessToken(ctx)
-	if err != nil {
-		log.Error("failed to get access token", slog.String("error", err.Error()))
-		return ErrAccessDenied
-	}
-	err = s.accessClient.Check(ctx, token, authService.ModeratorAccessLevel)
-	if err != nil {
-		log.Error("access check failed", slog.String("error", err.Error()))
-		return ErrAccessDenied
-	}
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		before, err := s.snapshots.Crop(ctx, id)
+		if err != nil {
+			log.Error("failed to get crop", slog.String("error", err.Error()))
+			if errors.Is(err, cropRepo.ErrNotFound) {
+				return ErrNotFound
+			}
 
-	if input.Status != nil {
-		status, _ := s.statusRepo.GetByStatus(ctx, *input.Status)
-		if err = s.cropRepo.Update(ctx, id, converter.ToRepoCropUpdateInput(input, &status.Id)); err != nil {
-			log.Error("failed to update crop", slog.String("error", err.Error()))
 			return ErrInternalServerError
 		}
-	} else {
-		if err = s.cropRepo.Update(ctx, id, converter.ToRepoCropUpdateInput(input, nil)); err != nil {
+		if err = s.policy.CanModify(ctx, before.Author, before.Status); err != nil {
+			log.Error("access check failed", slog.String("error", err.Error()))
+			return ErrAccessDenied
+		}
+
+		var statusId *int
+		if input.Status != nil {
+			newStatusId, err := s.workflow.Transition(ctx, before.Status, *input.Status)
+			if err != nil {
+				log.Error("failed to change crop status", slog.String("error", err.Error()))
+				return workflow.MapError(err)
+			}
+			statusId = &newStatusId
+		}
+
+		if err = s.cropRepo.Update(ctx, id, converter.ToRepoCropUpdateInput(input, statusId)); err != nil {
 			log.Error("failed to update crop", slog.String("error", err.Error()))
+			if errors.Is(err, cropRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+			if errors.Is(err, cropRepo.ErrInvalidArguments) {
+				return ErrMediaNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		after, err := s.snapshots.Crop(ctx, id)
+		if err != nil {
+			log.Error("failed to get updated crop", slog.String("error", err.Error()))
 			return ErrInternalServerError
 		}
-	}
 
-	return nil
+		return s.audit.Record(ctx, model.EntityCrop, id, model.AuditActionUpdate, before, after)
+	})
+}
+
+func (s *cropService) SetParent(ctx context.Context, id int, input *model.CropParentInput) error {
+	const op = "cropService.SetParent"
+	log := s.log.With(slog.String("op", op))
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		before, err := s.snapshots.Crop(ctx, id)
+		if err != nil {
+			log.Error("failed to get crop", slog.String("error", err.Error()))
+			if errors.Is(err, cropRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+		if err = s.policy.CanModify(ctx, before.Author, before.Status); err != nil {
+			log.Error("access check failed", slog.String("error", err.Error()))
+			return ErrAccessDenied
+		}
+
+		if input.ParentId != nil {
+			if _, err = s.cropRepo.GetById(ctx, *input.ParentId); err != nil {
+				log.Error("failed to get parent crop", slog.String("error", err.Error()))
+				if errors.Is(err, cropRepo.ErrNotFound) {
+					return ErrParentNotFound
+				}
+
+				return ErrInternalServerError
+			}
+
+			cycle, err := hierarchy.CreatesCycle(ctx, s.cropRepo, id, *input.ParentId)
+			if err != nil {
+				log.Error("failed to check parent crop ancestors", slog.String("error", err.Error()))
+				return ErrInternalServerError
+			}
+			if cycle {
+				return ErrCycle
+			}
+		}
+
+		if err = s.cropRepo.SetParent(ctx, id, input.ParentId); err != nil {
+			log.Error("failed to set parent crop", slog.String("error", err.Error()))
+			if errors.Is(err, cropRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+			if errors.Is(err, cropRepo.ErrInvalidArguments) {
+				return ErrParentNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		after, err := s.snapshots.Crop(ctx, id)
+		if err != nil {
+			log.Error("failed to get updated crop", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+
+		return s.audit.Record(ctx, model.EntityCrop, id, model.AuditActionUpdate, before, after)
+	})
 }
 
 func (s *cropService) Delete(ctx context.Context, id int) error {
 	const op = "cropService.Delete"
 	log := s.log.With(slog.String("op", op))
 
-	token, err := s.authClient.AccessToken(ctx)
-	if err != nil {
-		log.Error("failed to get access token", slog.String("error", err.Error()))
-		return ErrAccessDenied
-	}
-	err = s.accessClient.Check(ctx, token, authService.ModeratorAccessLevel)
-	if err != nil {
-		log.Error("access check failed", slog.String("error", err.Error()))
-		return ErrAccessDenied
-	}
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		before, err := s.snapshots.Crop(ctx, id)
+		if err != nil {
+			log.Error("failed to get crop", slog.String("error", err.Error()))
+			if errors.Is(err, cropRepo.ErrNotFound) {
+				return ErrNotFound
+			}
 
-	if err := s.cropRepo.Delete(ctx, id); err != nil {
-		log.Error("failed to delete crop", slog.String("error", err.Error()))
-		return ErrInternalServerError
-	}
+			return ErrInternalServerError
+		}
+		if err = s.policy.CanModify(ctx, before.Author, before.Status); err != nil {
+			log.Error("access check failed", slog.String("error", err.Error()))
+			return ErrAccessDenied
+		}
+
+		if err = s.cropRepo.Delete(ctx, id); err != nil {
+			log.Error("failed to delete crop", slog.String("error", err.Error()))
+			if errors.Is(err, cropRepo.ErrNotFound) {
+				return ErrNotFound
+			}
 
-	return nil
+			return ErrInternalServerError
+		}
+
+		return s.audit.Record(ctx, model.EntityCrop, id, model.AuditActionDelete, before, nil)
+	})
 }
 
 func (s *cropService) AddRelation(ctx context.Context, cropId int, categoryId int) error {
 	const op = "cropService.AddRelation"
 	log := s.log.With(slog.String("op", op))
 
-	token, err := s.authClient.AccessToken(ctx)
-	if err != nil {
-		log.Error("failed to get access token", slog.String("error", err.Error()))
-		return ErrAccessDenied
-	}
-	err = s.accessClient.Check(ctx, token, authService.UserAccessLevel)
-	if err != nil {
+	if err := s.checkAccess(ctx); err != nil {
 		log.Error("access check failed", slog.String("error", err.Error()))
 		return ErrAccessDenied
 	}
 
-	if err := s.cropCategoriesRepo.Create(ctx, cropId, categoryId); err != nil {
-		log.Error("failed to add crop category", slog.String("error", err.Error()))
-		return ErrInternalServerError
-	}
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if _, err := s.cropRepo.GetById(ctx, cropId); err != nil {
+			log.Error("failed to get crop", slog.String("error", err.Error()))
+			if errors.Is(err, cropRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		if err := s.cropCategoriesRepo.Create(ctx, cropId, categoryId); err != nil {
+			log.Error("failed to add crop category", slog.String("error", err.Error()))
+			if errors.Is(err, cropCategoriesRepo.ErrAlreadyExists) {
+				return ErrRelationExists
+			}
+			if errors.Is(err, cropCategoriesRepo.ErrNotFound) {
+				return ErrCategoryNotFound
+			}
+
+			return ErrInternalServerError
+		}
 
-	return nil
+		return s.audit.Record(ctx, model.EntityCrop, cropId, model.AuditActionAddRelation, nil, &model.AuditRelation{
+			EntityType: model.EntityCategory,
+			EntityId:   categoryId,
+		})
+	})
 }
 
 func (s *cropService) RemoveRelation(ctx context.Context, cropId int, categoryId int) error {
 	const op = "cropService.RemoveRelation"
 	log := s.log.With(slog.String("op", op))
 
-	token, err := s.authClient.AccessToken(ctx)
-	if err != nil {
-		log.Error("failed to get access token", slog.String("error", err.Error()))
+	if err := s.checkAccess(ctx); err != nil {
+		log.Error("access check failed", slog.String("error", err.Error()))
 		return ErrAccessDenied
 	}
-	err = s.accessClient.Check(ctx, token, authService.UserAccessLevel)
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if _, err := s.cropRepo.GetById(ctx, cropId); err != nil {
+			log.Error("failed to get crop", slog.String("error", err.Error()))
+			if errors.Is(err, cropRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		if err := s.cropCategoriesRepo.Delete(ctx, cropId, categoryId); err != nil {
+			log.Error("failed to remove crop category", slog.String("error", err.Error()))
+			if errors.Is(err, cropCategoriesRepo.ErrNotFound) {
+				return ErrRelationNotFound
+			}
+			if errors.Is(err, cropCategoriesRepo.ErrInUse) {
+				return ErrRelationInUse
+			}
+
+			return ErrInternalServerError
+		}
+
+		return s.audit.Record(ctx, model.EntityCrop, cropId, model.AuditActionRemoveRelation, &model.AuditRelation{
+			EntityType: model.EntityCategory,
+			EntityId:   categoryId,
+		}, nil)
+	})
+}
+
+// checkAccess lets any signed in user link crops and categories, the links are not part of the crop itself.
+func (s *cropService) checkAccess(ctx context.Context) error {
+	token, err := s.authClient.AccessToken(ctx)
 	if err != nil {
-		log.Error("access check failed", slog.String("error", err.Error()))
-		return ErrAccessDenied
+		return err
+	}
+
+	return s.accessClient.Check(ctx, token, authService.UserAccessLevel)
+}
+
+// imgVariants loads the variants of the crop images at once, keyed by media id.
+func (s *cropService) imgVariants(ctx context.Context, crops []cropRepoModel.Crop) (map[int]model.ImageVariants, error) {
+	mediaIds := make([]int, 0, len(crops))
+	for _, crop := range crops {
+		if crop.ImgId != nil {
+			mediaIds = append(mediaIds, *crop.ImgId)
+		}
 	}
 
-	if err = s.cropCategoriesRepo.Delete(ctx, cropId, categoryId); err != nil {
-		log.Error("failed to remove crop category", slog.String("error", err.Error()))
-		return ErrInternalServerError
+	variants, err := s.mediaVariantsRepo.GetAllByMediaIds(ctx, mediaIds)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return converter.ToMediaImageVariants(variants), nil
 }
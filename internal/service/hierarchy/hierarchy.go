@@ -0,0 +1,46 @@
+package hierarchy
+
+import (
+	"context"
+	"slices"
+)
+
+// Tree is a repository of items linked into a tree by their parent ids, like crops and categories.
+type Tree interface {
+	GetAncestorIds(ctx context.Context, id int) ([]int, error)
+	LockAncestorIds(ctx context.Context, ids []int) ([]int, error)
+}
+
+// CreatesCycle reports whether making parentId the parent of id would make id its own ancestor.
+// It has to run in the transaction that moves id: the item and the ancestor chain of parentId stay locked
+// until it ends, so a concurrent move can't close a cycle between the check and the update.
+func CreatesCycle(ctx context.Context, tree Tree, id int, parentId int) (bool, error) {
+	locked, err := tree.LockAncestorIds(ctx, []int{id, parentId})
+	if err != nil {
+		return false, err
+	}
+
+	// The chain may have changed while waiting for the locks, it is stable once every ancestor is locked.
+	for {
+		ancestors, err := tree.GetAncestorIds(ctx, parentId)
+		if err != nil {
+			return false, err
+		}
+		if slices.Contains(ancestors, id) {
+			return true, nil
+		}
+
+		unlocked := slices.DeleteFunc(ancestors, func(ancestorId int) bool {
+			return slices.Contains(locked, ancestorId)
+		})
+		if len(unlocked) == 0 {
+			return false, nil
+		}
+
+		more, err := tree.LockAncestorIds(ctx, unlocked)
+		if err != nil {
+			return false, err
+		}
+		locked = append(locked, more...)
+	}
+}
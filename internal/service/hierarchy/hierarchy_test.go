@@ -0,0 +1,104 @@
+package hierarchy
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+// tree keeps parent ids in memory, onLock runs after every lock to simulate moves committed meanwhile.
+type tree struct {
+	parents map[int]int
+	locked  []int
+	onLock  func(t *tree)
+}
+
+func (t *tree) GetAncestorIds(_ context.Context, id int) ([]int, error) {
+	var ids []int
+	for ; id != 0 && !slices.Contains(ids, id); id = t.parents[id] {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (t *tree) LockAncestorIds(ctx context.Context, ids []int) ([]int, error) {
+	var locked []int
+	for _, id := range ids {
+		ancestors, _ := t.GetAncestorIds(ctx, id)
+		for _, ancestorId := range ancestors {
+			if !slices.Contains(locked, ancestorId) {
+				locked = append(locked, ancestorId)
+			}
+		}
+	}
+	t.locked = append(t.locked, locked...)
+
+	if t.onLock != nil {
+		onLock := t.onLock
+		t.onLock = nil
+		onLock(t)
+	}
+
+	return locked, nil
+}
+
+func TestCreatesCycle(t *testing.T) {
+	tests := []struct {
+		name     string
+		parents  map[int]int
+		onLock   func(t *tree)
+		id       int
+		parentId int
+		want     bool
+	}{
+		{name: "unrelated parent", parents: map[int]int{2: 1}, id: 3, parentId: 2, want: false},
+		{name: "own parent", parents: map[int]int{}, id: 1, parentId: 1, want: true},
+		{name: "direct child", parents: map[int]int{2: 1}, id: 1, parentId: 2, want: true},
+		{name: "deep descendant", parents: map[int]int{2: 1, 3: 2, 4: 3}, id: 1, parentId: 4, want: true},
+		{name: "sibling", parents: map[int]int{2: 1, 3: 1}, id: 2, parentId: 3, want: false},
+		{
+			name:    "chain moved under the item while locking",
+			parents: map[int]int{3: 2},
+			onLock: func(tr *tree) {
+				tr.parents[2] = 1
+			},
+			id:       1,
+			parentId: 3,
+			want:     true,
+		},
+		{
+			name:    "chain extended while locking",
+			parents: map[int]int{3: 2},
+			onLock: func(tr *tree) {
+				tr.parents[2] = 5
+			},
+			id:       1,
+			parentId: 3,
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := &tree{parents: tt.parents, onLock: tt.onLock}
+
+			got, err := CreatesCycle(context.Background(), tr, tt.id, tt.parentId)
+			if err != nil {
+				t.Fatalf("CreatesCycle() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("CreatesCycle() = %t, want %t", got, tt.want)
+			}
+
+			if got {
+				return
+			}
+			ancestors, _ := tr.GetAncestorIds(context.Background(), tt.parentId)
+			for _, id := range append(ancestors, tt.id) {
+				if !slices.Contains(tr.locked, id) {
+					t.Errorf("CreatesCycle() left %d unlocked", id)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,321 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"github.com/nogavadu/articles-service/internal/domain/converter"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/apperr"
+	"github.com/nogavadu/articles-service/internal/lib/identity"
+	"github.com/nogavadu/articles-service/internal/lib/imaging"
+	"github.com/nogavadu/articles-service/internal/repository"
+	mediaRepo "github.com/nogavadu/articles-service/internal/repository/media"
+	mediaRepoModel "github.com/nogavadu/articles-service/internal/repository/media/model"
+	variantRepo "github.com/nogavadu/articles-service/internal/repository/media_variants"
+	variantRepoModel "github.com/nogavadu/articles-service/internal/repository/media_variants/model"
+	"github.com/nogavadu/articles-service/internal/service"
+	"github.com/nogavadu/articles-service/internal/storage"
+	"github.com/nogavadu/platform_common/pkg/db"
+	"io"
+	"log/slog"
+	"net/http"
+)
+
+var (
+	ErrNotFound            = apperr.New(apperr.ErrNotFound, "media not found")
+	ErrTooLarge            = apperr.New(apperr.ErrTooLarge, "file is too large")
+	ErrEmpty               = apperr.New(apperr.ErrInvalidArguments, "file is empty")
+	ErrUnsupportedType     = apperr.New(apperr.ErrInvalidArguments, "unsupported file type, expected jpeg, png, gif or webp image")
+	ErrInvalidImage        = apperr.New(apperr.ErrInvalidArguments, "image is corrupted or its dimensions are too large")
+	ErrInternalServerError = errors.New("internal server error")
+	ErrAccessDenied        = apperr.New(apperr.ErrAccessDenied, "access denied")
+)
+
+// extensions maps the accepted sniffed content types to the extension of the stored file.
+var extensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// variantSizes is the longest side of every variant generated on upload.
+var variantSizes = []struct {
+	name    string
+	maxSide int
+}{
+	{name: model.ImageVariantThumb, maxSide: 160},
+	{name: model.ImageVariantMedium, maxSide: 640},
+	{name: model.ImageVariantLarge, maxSide: 1280},
+}
+
+type mediaService struct {
+	log *slog.Logger
+
+	mediaRepo    repository.MediaRepository
+	variantsRepo repository.MediaVariantsRepository
+	storage      storage.Storage
+	txManager    db.TxManager
+	maxSize      int64
+}
+
+func New(
+	log *slog.Logger,
+	mediaRepo repository.MediaRepository,
+	variantsRepo repository.MediaVariantsRepository,
+	storage storage.Storage,
+	txManager db.TxManager,
+	maxSize int64,
+) service.MediaService {
+	return &mediaService{
+		log:          log,
+		mediaRepo:    mediaRepo,
+		variantsRepo: variantsRepo,
+		storage:      storage,
+		txManager:    txManager,
+		maxSize:      maxSize,
+	}
+}
+
+// variant is a resized copy of an upload that is yet to be stored.
+type variant struct {
+	info variantRepoModel.VariantInfo
+	data []byte
+}
+
+// Upload stores the file under its checksum, uploading the same content twice returns the already stored media.
+// The content type is sniffed from the content, whatever the client claims is ignored.
+// Downscaled variants are generated for every upload, webp ones are re-encoded as jpeg or png.
+func (s *mediaService) Upload(ctx context.Context, r io.Reader) (*model.Media, error) {
+	const op = "mediaService.Upload"
+	log := s.log.With(slog.String("op", op))
+
+	userId, ok := identity.UserId(ctx)
+	if !ok {
+		log.Error("caller is not authenticated")
+		return nil, ErrAccessDenied
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, s.maxSize+1))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return nil, ErrTooLarge
+		}
+
+		log.Error("failed to read file", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+	if len(data) == 0 {
+		return nil, ErrEmpty
+	}
+	if int64(len(data)) > s.maxSize {
+		return nil, ErrTooLarge
+	}
+
+	contentType := http.DetectContentType(data)
+	ext, ok := extensions[contentType]
+	if !ok {
+		log.Info("rejected upload", slog.String("content_type", contentType))
+		return nil, ErrUnsupportedType
+	}
+
+	sum := sha256.Sum256(data)
+	checksum := hex.EncodeToString(sum[:])
+
+	existing, err := s.mediaRepo.GetByChecksum(ctx, checksum)
+	if err == nil {
+		return s.withVariants(ctx, existing)
+	}
+	if !errors.Is(err, mediaRepo.ErrNotFound) {
+		log.Error("failed to get media", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	key := checksum + ext
+	info := &mediaRepoModel.MediaInfo{
+		Key:         key,
+		Url:         s.storage.URL(key),
+		Checksum:    checksum,
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		Author:      &userId,
+	}
+
+	variants, err := s.resize(info, data)
+	if err != nil {
+		log.Info("rejected upload", slog.String("error", err.Error()))
+		return nil, ErrInvalidImage
+	}
+
+	files := map[string][]byte{key: data}
+	variantInfos := make([]variantRepoModel.VariantInfo, 0, len(variants))
+	for _, v := range variants {
+		files[v.info.Key] = v.data
+		variantInfos = append(variantInfos, v.info)
+	}
+
+	// The files are stored once the rows exist: a concurrent upload of the same content waits on the checksum
+	// until this transaction ends, so removing the files of a failed upload can't remove its files.
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		id, err := s.mediaRepo.Create(ctx, info)
+		if err != nil {
+			return err
+		}
+		if err = s.variantsRepo.CreateBulk(ctx, id, variantInfos); err != nil {
+			return err
+		}
+
+		return s.store(ctx, log, files)
+	})
+	// A concurrent upload of the same content won the race, the stored files are identical.
+	if err != nil && !errors.Is(err, mediaRepo.ErrAlreadyExists) {
+		log.Error("failed to create media", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	media, err := s.mediaRepo.GetByChecksum(ctx, checksum)
+	if err != nil {
+		log.Error("failed to get media", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	return s.withVariants(ctx, media)
+}
+
+// store puts every file in the storage, if one fails the ones already stored are removed.
+func (s *mediaService) store(ctx context.Context, log *slog.Logger, files map[string][]byte) error {
+	stored := make([]string, 0, len(files))
+	for key, data := range files {
+		if err := s.storage.Put(ctx, key, bytes.NewReader(data)); err != nil {
+			for _, storedKey := range stored {
+				if err := s.storage.Delete(ctx, storedKey); err != nil {
+					log.Warn("failed to remove stored file", slog.String("key", storedKey), slog.String("error", err.Error()))
+				}
+			}
+
+			return err
+		}
+		stored = append(stored, key)
+	}
+
+	return nil
+}
+
+// resize decodes the upload, records its dimensions in info and resizes it to every variant it is larger than.
+func (s *mediaService) resize(info *mediaRepoModel.MediaInfo, data []byte) ([]variant, error) {
+	decoded, format, err := imaging.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	img := imaging.ToRGBA(decoded)
+
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+	info.Width, info.Height = &width, &height
+
+	var variants []variant
+	for _, size := range variantSizes {
+		resized, ok := imaging.Fit(img, size.maxSide)
+		if !ok {
+			continue
+		}
+
+		var buf bytes.Buffer
+		written, err := imaging.Encode(&buf, resized, format)
+		if err != nil {
+			return nil, err
+		}
+
+		contentType := "image/" + written
+		key := info.Checksum + "-" + size.name + extensions[contentType]
+		variants = append(variants, variant{
+			info: variantRepoModel.VariantInfo{
+				Name:        size.name,
+				Key:         key,
+				Url:         s.storage.URL(key),
+				ContentType: contentType,
+				Size:        int64(buf.Len()),
+				Width:       resized.Bounds().Dx(),
+				Height:      resized.Bounds().Dy(),
+			},
+			data: buf.Bytes(),
+		})
+	}
+
+	return variants, nil
+}
+
+func (s *mediaService) withVariants(ctx context.Context, media *mediaRepoModel.Media) (*model.Media, error) {
+	variants, err := s.variantsRepo.GetAllByMediaIds(ctx, []int{media.Id})
+	if err != nil {
+		s.log.Error("failed to get media variants", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	return converter.ToMedia(media, variants[media.Id]), nil
+}
+
+func (s *mediaService) GetById(ctx context.Context, id int) (*model.Media, error) {
+	const op = "mediaService.GetById"
+	log := s.log.With(slog.String("op", op))
+
+	media, err := s.mediaRepo.GetById(ctx, id)
+	if err != nil {
+		log.Error("failed to get media", slog.String("error", err.Error()))
+		if errors.Is(err, mediaRepo.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, ErrInternalServerError
+	}
+
+	return s.withVariants(ctx, media)
+}
+
+// Open returns the stored original or variant by its key, the caller must close it.
+func (s *mediaService) Open(ctx context.Context, key string) (*model.MediaFile, io.ReadCloser, error) {
+	const op = "mediaService.Open"
+	log := s.log.With(slog.String("op", op))
+
+	mediaFile, err := s.mediaFile(ctx, key)
+	if err != nil {
+		log.Error("failed to get media", slog.String("error", err.Error()))
+		if errors.Is(err, mediaRepo.ErrNotFound) || errors.Is(err, variantRepo.ErrNotFound) {
+			return nil, nil, ErrNotFound
+		}
+
+		return nil, nil, ErrInternalServerError
+	}
+
+	file, err := s.storage.Open(ctx, mediaFile.Key)
+	if err != nil {
+		log.Error("failed to open file", slog.String("error", err.Error()))
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil, ErrNotFound
+		}
+
+		return nil, nil, ErrInternalServerError
+	}
+
+	return mediaFile, file, nil
+}
+
+func (s *mediaService) mediaFile(ctx context.Context, key string) (*model.MediaFile, error) {
+	media, err := s.mediaRepo.GetByKey(ctx, key)
+	if err == nil {
+		return &model.MediaFile{Key: media.Key, ContentType: media.ContentType, Size: media.Size}, nil
+	}
+	if !errors.Is(err, mediaRepo.ErrNotFound) {
+		return nil, err
+	}
+
+	v, err := s.variantsRepo.GetByKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.MediaFile{Key: v.Key, ContentType: v.ContentType, Size: v.Size}, nil
+}
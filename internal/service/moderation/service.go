@@ -0,0 +1,294 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	authService "github.com/nogavadu/articles-service/internal/clients/auth-service/grpc"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/apperr"
+	"github.com/nogavadu/articles-service/internal/repository"
+	articleRepo "github.com/nogavadu/articles-service/internal/repository/article"
+	articleRepoModel "github.com/nogavadu/articles-service/internal/repository/article/model"
+	categoryRepo "github.com/nogavadu/articles-service/internal/repository/category"
+	categoryRepoModel "github.com/nogavadu/articles-service/internal/repository/category/model"
+	cropRepo "github.com/nogavadu/articles-service/internal/repository/crop"
+	cropRepoModel "github.com/nogavadu/articles-service/internal/repository/crop/model"
+	pestRepo "github.com/nogavadu/articles-service/internal/repository/pest"
+	pestRepoModel "github.com/nogavadu/articles-service/internal/repository/pest/model"
+	"github.com/nogavadu/articles-service/internal/service"
+	"github.com/nogavadu/articles-service/internal/service/workflow"
+	"github.com/nogavadu/platform_common/pkg/db"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	ErrNotFound            = apperr.New(apperr.ErrNotFound, "entity not found")
+	ErrInvalidArguments    = apperr.New(apperr.ErrInvalidArguments, "invalid arguments")
+	ErrInternalServerError = errors.New("internal server error")
+	ErrAccessDenied        = apperr.New(apperr.ErrAccessDenied, "access denied")
+	ErrIllegalTransition   = apperr.New(apperr.ErrIllegalTransition, "entity is not awaiting review")
+)
+
+type moderationService struct {
+	log *slog.Logger
+
+	cropRepo     repository.CropRepository
+	categoryRepo repository.CategoryRepository
+	articleRepo  repository.ArticleRepository
+	pestRepo     repository.PestRepository
+	statusRepo   repository.StatusRepository
+	workflow     service.StatusWorkflow
+	audit        service.AuditService
+	snapshots    service.SnapshotLoader
+	txManager    db.TxManager
+
+	accessClient *authService.AccessServiceClient
+	authClient   *authService.AuthServiceClient
+	userClient   *authService.UserServiceClient
+}
+
+func New(
+	log *slog.Logger,
+	cropRepo repository.CropRepository,
+	categoryRepo repository.CategoryRepository,
+	articleRepo repository.ArticleRepository,
+	pestRepo repository.PestRepository,
+	statusRepo repository.StatusRepository,
+	workflow service.StatusWorkflow,
+	audit service.AuditService,
+	snapshots service.SnapshotLoader,
+	txManager db.TxManager,
+	accessClient *authService.AccessServiceClient,
+	authClient *authService.AuthServiceClient,
+	userClient *authService.UserServiceClient,
+) service.ModerationService {
+	return &moderationService{
+		log:          log,
+		cropRepo:     cropRepo,
+		categoryRepo: categoryRepo,
+		articleRepo:  articleRepo,
+		pestRepo:     pestRepo,
+		statusRepo:   statusRepo,
+		workflow:     workflow,
+		audit:        audit,
+		snapshots:    snapshots,
+		txManager:    txManager,
+		accessClient: accessClient,
+		authClient:   authClient,
+		userClient:   userClient,
+	}
+}
+
+// GetQueue returns crops, categories, articles and pests awaiting review, oldest submissions first.
+func (s *moderationService) GetQueue(ctx context.Context) ([]model.ModerationItem, error) {
+	const op = "moderationService.GetQueue"
+	log := s.log.With(slog.String("op", op))
+
+	token, err := s.authClient.AccessToken(ctx)
+	if err != nil {
+		log.Error("failed to get access token", slog.String("error", err.Error()))
+		return nil, ErrAccessDenied
+	}
+	if err = s.accessClient.Check(ctx, token, authService.ModeratorAccessLevel); err != nil {
+		log.Error("access check failed", slog.String("error", err.Error()))
+		return nil, ErrAccessDenied
+	}
+
+	statusId, err := s.workflow.ListStatus(ctx, nil, model.StatusReview)
+	if err != nil {
+		log.Error("failed to resolve review status", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	crops, err := s.cropRepo.GetAll(ctx, statusId)
+	if err != nil {
+		log.Error("failed to get crops", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	categories, err := s.categoryRepo.GetAll(ctx, &categoryRepoModel.CategoryGetAllParams{Status: statusId})
+	if err != nil {
+		log.Error("failed to get categories", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	articles, err := s.articleRepo.GetAll(ctx, &articleRepoModel.ArticleGetAllParams{
+		Status: statusId,
+		Sort:   model.ArticleSortCreatedAt,
+	})
+	if err != nil {
+		log.Error("failed to get articles", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	pests, err := s.pestRepo.GetAll(ctx, &pestRepoModel.PestGetAllParams{Status: statusId})
+	if err != nil {
+		log.Error("failed to get pests", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	items := make([]model.ModerationItem, 0, len(crops)+len(categories)+len(articles)+len(pests))
+	authorIds := make([]*int, 0, cap(items))
+	for _, c := range crops {
+		items = append(items, model.ModerationItem{EntityType: model.EntityCrop, EntityId: c.ID, Title: c.Name, SubmittedAt: c.UpdatedAt})
+		authorIds = append(authorIds, c.Author)
+	}
+	for _, c := range categories {
+		items = append(items, model.ModerationItem{EntityType: model.EntityCategory, EntityId: c.ID, Title: c.Name, SubmittedAt: c.UpdatedAt})
+		authorIds = append(authorIds, c.Author)
+	}
+	for _, a := range articles {
+		items = append(items, model.ModerationItem{EntityType: model.EntityArticle, EntityId: a.Id, Title: a.Title, SubmittedAt: a.UpdatedAt})
+		authorIds = append(authorIds, a.Author)
+	}
+	for _, p := range pests {
+		items = append(items, model.ModerationItem{EntityType: model.EntityPest, EntityId: p.ID, Title: p.Name, SubmittedAt: p.UpdatedAt})
+		authorIds = append(authorIds, p.Author)
+	}
+
+	ids := make([]int, 0, len(authorIds))
+	for _, id := range authorIds {
+		if id != nil {
+			ids = append(ids, *id)
+		}
+	}
+	authors, err := s.userClient.GetByIds(ctx, ids)
+	if err != nil {
+		log.Error("failed to get authors", slog.String("error", err.Error()))
+	}
+
+	now := time.Now()
+	for i := range items {
+		if authorIds[i] != nil {
+			items[i].Author = authors[*authorIds[i]]
+		}
+		items[i].AgeSeconds = int64(now.Sub(items[i].SubmittedAt).Seconds())
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].SubmittedAt.Before(items[j].SubmittedAt)
+	})
+
+	return items, nil
+}
+
+func (s *moderationService) Approve(ctx context.Context, entityType string, id int) error {
+	const op = "moderationService.Approve"
+	log := s.log.With(slog.String("op", op))
+
+	empty := ""
+	if err := s.resolve(ctx, entityType, id, model.StatusPublished, &empty); err != nil {
+		log.Error("failed to approve", slog.String("entity", entityType), slog.Int("id", id), slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+func (s *moderationService) Reject(ctx context.Context, entityType string, id int, reason string) error {
+	const op = "moderationService.Reject"
+	log := s.log.With(slog.String("op", op))
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return ErrInvalidArguments
+	}
+
+	if err := s.resolve(ctx, entityType, id, model.StatusCanceled, &reason); err != nil {
+		log.Error("failed to reject", slog.String("entity", entityType), slog.Int("id", id), slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// resolve moves an entity out of review into the given status and stores the rejection reason alongside it.
+func (s *moderationService) resolve(ctx context.Context, entityType string, id int, to string, reason *string) error {
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var (
+			currentStatus int
+			err           error
+		)
+		switch entityType {
+		case model.EntityCrop:
+			var crop *cropRepoModel.Crop
+			if crop, err = s.cropRepo.GetById(ctx, id); err == nil {
+				currentStatus = crop.Status
+			}
+		case model.EntityCategory:
+			var category *categoryRepoModel.Category
+			if category, err = s.categoryRepo.GetById(ctx, id); err == nil {
+				currentStatus = category.Status
+			}
+		case model.EntityArticle:
+			var article *articleRepoModel.Article
+			if article, err = s.articleRepo.GetById(ctx, id); err == nil {
+				currentStatus = article.Status
+			}
+		case model.EntityPest:
+			var pest *pestRepoModel.Pest
+			if pest, err = s.pestRepo.GetById(ctx, id); err == nil {
+				currentStatus = pest.Status
+			}
+		default:
+			return ErrInvalidArguments
+		}
+		if err != nil {
+			if errors.Is(err, cropRepo.ErrNotFound) || errors.Is(err, categoryRepo.ErrNotFound) ||
+				errors.Is(err, articleRepo.ErrNotFound) || errors.Is(err, pestRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		before, err := s.snapshots.Entity(ctx, entityType, id)
+		if err != nil {
+			return ErrInternalServerError
+		}
+
+		status, err := s.statusRepo.GetById(ctx, currentStatus)
+		if err != nil {
+			return ErrInternalServerError
+		}
+		if status.Status != model.StatusReview {
+			return ErrIllegalTransition
+		}
+
+		statusId, err := s.workflow.Transition(ctx, status.Status, to)
+		if err != nil {
+			switch {
+			case errors.Is(err, workflow.ErrAccessDenied):
+				return ErrAccessDenied
+			case errors.Is(err, workflow.ErrIllegalTransition):
+				return ErrIllegalTransition
+			default:
+				return ErrInternalServerError
+			}
+		}
+
+		switch entityType {
+		case model.EntityCrop:
+			err = s.cropRepo.Update(ctx, id, &cropRepoModel.UpdateInput{Status: &statusId, RejectionReason: reason})
+		case model.EntityCategory:
+			err = s.categoryRepo.Update(ctx, id, &categoryRepoModel.UpdateInput{Status: &statusId, RejectionReason: reason})
+		case model.EntityArticle:
+			err = s.articleRepo.Update(ctx, id, &articleRepoModel.UpdateInput{Status: &statusId, RejectionReason: reason})
+		case model.EntityPest:
+			err = s.pestRepo.Update(ctx, id, &pestRepoModel.UpdateInput{Status: &statusId, RejectionReason: reason})
+		}
+		if err != nil {
+			return ErrInternalServerError
+		}
+
+		after, err := s.snapshots.Entity(ctx, entityType, id)
+		if err != nil {
+			return ErrInternalServerError
+		}
+
+		return s.audit.Record(ctx, entityType, id, model.AuditActionUpdate, before, after)
+	})
+}
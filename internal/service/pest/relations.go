@@ -0,0 +1,151 @@
+package pest
+
+import (
+	"context"
+	"errors"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	articleRepo "github.com/nogavadu/articles-service/internal/repository/article"
+	cropRepo "github.com/nogavadu/articles-service/internal/repository/crop"
+	pestRepo "github.com/nogavadu/articles-service/internal/repository/pest"
+	pestRelationsRepo "github.com/nogavadu/articles-service/internal/repository/pest_relations"
+	"log/slog"
+)
+
+// AddCrop marks the crop as affected by the pest.
+func (s *pestService) AddCrop(ctx context.Context, pestId int, cropId int) error {
+	const op = "pestService.AddCrop"
+	log := s.log.With(slog.String("op", op))
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if err := s.canModify(ctx, pestId); err != nil {
+			log.Error("failed to check pest access", slog.String("error", err.Error()))
+			return err
+		}
+
+		if _, err := s.cropRepo.GetById(ctx, cropId); err != nil {
+			log.Error("failed to get crop", slog.String("error", err.Error()))
+			if errors.Is(err, cropRepo.ErrNotFound) {
+				return ErrCropNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		if err := s.pestRelationsRepo.AddCrop(ctx, pestId, cropId); err != nil {
+			log.Error("failed to add pest crop", slog.String("error", err.Error()))
+			return relationErr(err, ErrCropNotFound)
+		}
+
+		return s.audit.Record(ctx, model.EntityPest, pestId, model.AuditActionAddRelation, nil, &model.AuditRelation{
+			EntityType: model.EntityCrop,
+			EntityId:   cropId,
+		})
+	})
+}
+
+func (s *pestService) RemoveCrop(ctx context.Context, pestId int, cropId int) error {
+	const op = "pestService.RemoveCrop"
+	log := s.log.With(slog.String("op", op))
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if err := s.canModify(ctx, pestId); err != nil {
+			log.Error("failed to check pest access", slog.String("error", err.Error()))
+			return err
+		}
+
+		if err := s.pestRelationsRepo.RemoveCrop(ctx, pestId, cropId); err != nil {
+			log.Error("failed to remove pest crop", slog.String("error", err.Error()))
+			return relationErr(err, ErrCropNotFound)
+		}
+
+		return s.audit.Record(ctx, model.EntityPest, pestId, model.AuditActionRemoveRelation, &model.AuditRelation{
+			EntityType: model.EntityCrop,
+			EntityId:   cropId,
+		}, nil)
+	})
+}
+
+// AddArticle links an article on preventing or treating the pest.
+func (s *pestService) AddArticle(ctx context.Context, pestId int, articleId int) error {
+	const op = "pestService.AddArticle"
+	log := s.log.With(slog.String("op", op))
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if err := s.canModify(ctx, pestId); err != nil {
+			log.Error("failed to check pest access", slog.String("error", err.Error()))
+			return err
+		}
+
+		if _, err := s.articleRepo.GetById(ctx, articleId); err != nil {
+			log.Error("failed to get article", slog.String("error", err.Error()))
+			if errors.Is(err, articleRepo.ErrNotFound) {
+				return ErrArticleNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		if err := s.pestRelationsRepo.AddArticle(ctx, pestId, articleId); err != nil {
+			log.Error("failed to add pest article", slog.String("error", err.Error()))
+			return relationErr(err, ErrArticleNotFound)
+		}
+
+		return s.audit.Record(ctx, model.EntityPest, pestId, model.AuditActionAddRelation, nil, &model.AuditRelation{
+			EntityType: model.EntityArticle,
+			EntityId:   articleId,
+		})
+	})
+}
+
+func (s *pestService) RemoveArticle(ctx context.Context, pestId int, articleId int) error {
+	const op = "pestService.RemoveArticle"
+	log := s.log.With(slog.String("op", op))
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		if err := s.canModify(ctx, pestId); err != nil {
+			log.Error("failed to check pest access", slog.String("error", err.Error()))
+			return err
+		}
+
+		if err := s.pestRelationsRepo.RemoveArticle(ctx, pestId, articleId); err != nil {
+			log.Error("failed to remove pest article", slog.String("error", err.Error()))
+			return relationErr(err, ErrArticleNotFound)
+		}
+
+		return s.audit.Record(ctx, model.EntityPest, pestId, model.AuditActionRemoveRelation, &model.AuditRelation{
+			EntityType: model.EntityArticle,
+			EntityId:   articleId,
+		}, nil)
+	})
+}
+
+func (s *pestService) canModify(ctx context.Context, pestId int) error {
+	pest, err := s.snapshots.Pest(ctx, pestId)
+	if err != nil {
+		if errors.Is(err, pestRepo.ErrNotFound) {
+			return ErrNotFound
+		}
+
+		return ErrInternalServerError
+	}
+
+	if err = s.policy.CanModify(ctx, pest.Author, pest.Status); err != nil {
+		return ErrAccessDenied
+	}
+
+	return nil
+}
+
+// relationErr maps link errors, notFound is returned when the linked entity doesn't exist.
+func relationErr(err error, notFound error) error {
+	switch {
+	case errors.Is(err, pestRelationsRepo.ErrAlreadyExists):
+		return ErrRelationExists
+	case errors.Is(err, pestRelationsRepo.ErrNotFound):
+		return ErrRelationNotFound
+	case errors.Is(err, pestRelationsRepo.ErrInvalidArguments):
+		return notFound
+	default:
+		return ErrInternalServerError
+	}
+}
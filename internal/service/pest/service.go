@@ -0,0 +1,352 @@
+package pest
+
+import (
+	"context"
+	"errors"
+	authService "github.com/nogavadu/articles-service/internal/clients/auth-service/grpc"
+	"github.com/nogavadu/articles-service/internal/domain/converter"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/apperr"
+	"github.com/nogavadu/articles-service/internal/lib/identity"
+	"github.com/nogavadu/articles-service/internal/repository"
+	pestRepo "github.com/nogavadu/articles-service/internal/repository/pest"
+	pestImagesRepo "github.com/nogavadu/articles-service/internal/repository/pest_images"
+	"github.com/nogavadu/articles-service/internal/service"
+	"github.com/nogavadu/articles-service/internal/service/workflow"
+	"github.com/nogavadu/platform_common/pkg/db"
+	"log/slog"
+)
+
+var (
+	ErrNotFound            = apperr.New(apperr.ErrNotFound, "pest not found")
+	ErrAlreadyExists       = apperr.New(apperr.ErrAlreadyExists, "pest already exists")
+	ErrInvalidArguments    = apperr.New(apperr.ErrInvalidArguments, "invalid pest arguments")
+	ErrInternalServerError = errors.New("internal server error")
+	ErrAccessDenied        = apperr.New(apperr.ErrAccessDenied, "access denied")
+	ErrIllegalTransition   = apperr.New(apperr.ErrIllegalTransition, "illegal status transition")
+	ErrCropNotFound        = apperr.New(apperr.ErrNotFound, "crop not found")
+	ErrArticleNotFound     = apperr.New(apperr.ErrNotFound, "article not found")
+	ErrRelationNotFound    = apperr.New(apperr.ErrNotFound, "entity is not linked to the pest")
+	ErrRelationExists      = apperr.New(apperr.ErrAlreadyExists, "entity is already linked to the pest")
+	ErrMediaNotFound       = apperr.New(apperr.ErrNotFound, "media not found")
+)
+
+type pestService struct {
+	log *slog.Logger
+
+	pestRepo          repository.PestRepository
+	pestImagesRepo    repository.PestImagesRepository
+	pestRelationsRepo repository.PestRelationsRepository
+	cropRepo          repository.CropRepository
+	articleRepo       repository.ArticleRepository
+	statusRepo        repository.StatusRepository
+	workflow          service.StatusWorkflow
+	policy            service.AccessPolicy
+	audit             service.AuditService
+	snapshots         service.SnapshotLoader
+	txManager         db.TxManager
+
+	userClient *authService.UserServiceClient
+}
+
+func New(
+	log *slog.Logger,
+	pestRepo repository.PestRepository,
+	pestImagesRepo repository.PestImagesRepository,
+	pestRelationsRepo repository.PestRelationsRepository,
+	cropRepo repository.CropRepository,
+	articleRepo repository.ArticleRepository,
+	statusRepo repository.StatusRepository,
+	workflow service.StatusWorkflow,
+	policy service.AccessPolicy,
+	audit service.AuditService,
+	snapshots service.SnapshotLoader,
+	txManager db.TxManager,
+	userClient *authService.UserServiceClient,
+) service.PestService {
+	return &pestService{
+		log:               log,
+		pestRepo:          pestRepo,
+		pestImagesRepo:    pestImagesRepo,
+		pestRelationsRepo: pestRelationsRepo,
+		cropRepo:          cropRepo,
+		articleRepo:       articleRepo,
+		statusRepo:        statusRepo,
+		workflow:          workflow,
+		policy:            policy,
+		audit:             audit,
+		snapshots:         snapshots,
+		txManager:         txManager,
+		userClient:        userClient,
+	}
+}
+
+func (s *pestService) Create(ctx context.Context, info *model.PestInfo) (int, error) {
+	const op = "pestService.Create"
+	log := s.log.With(slog.String("op", op))
+
+	userId, ok := identity.UserId(ctx)
+	if !ok {
+		log.Error("caller is not authenticated")
+		return 0, ErrAccessDenied
+	}
+
+	statusId, err := s.workflow.InitialStatus(ctx, info.Status)
+	if err != nil {
+		log.Error("failed to resolve initial status", slog.String("error", err.Error()))
+		return 0, workflow.MapError(err)
+	}
+
+	var pestId int
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		id, err := s.pestRepo.Create(ctx, converter.ToRepoPestInfo(info, statusId, userId))
+		if err != nil {
+			log.Error("failed to create pest", slog.String("error", err.Error()))
+			if errors.Is(err, pestRepo.ErrAlreadyExists) {
+				return ErrAlreadyExists
+			}
+
+			return ErrInternalServerError
+		}
+
+		if len(info.ImageIds) > 0 {
+			if err = s.pestImagesRepo.CreateBulk(ctx, id, info.ImageIds); err != nil {
+				log.Error("failed to create pest images", slog.String("error", err.Error()))
+				return imagesErr(err)
+			}
+		}
+
+		after, err := s.snapshots.Pest(ctx, id)
+		if err != nil {
+			log.Error("failed to get created pest", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+
+		pestId = id
+		return s.audit.Record(ctx, model.EntityPest, id, model.AuditActionCreate, nil, after)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return pestId, nil
+}
+
+func (s *pestService) GetAll(ctx context.Context, params *model.PestGetAllParams) ([]model.Pest, error) {
+	const op = "pestService.GetAll"
+	log := s.log.With(slog.String("op", op))
+
+	statusId, err := s.workflow.ListStatus(ctx, params.Status, model.StatusPublished)
+	if err != nil {
+		log.Error("failed to resolve status", slog.String("error", err.Error()))
+		return nil, workflow.MapError(err)
+	}
+
+	repoPests, err := s.pestRepo.GetAll(ctx, converter.ToRepoPestGetAllParams(params, statusId))
+	if err != nil {
+		log.Error("failed to get pests", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	pestIds := make([]int, 0, len(repoPests))
+	authorIds := make([]int, 0, len(repoPests))
+	for _, repoPest := range repoPests {
+		pestIds = append(pestIds, repoPest.ID)
+		if repoPest.Author != nil {
+			authorIds = append(authorIds, *repoPest.Author)
+		}
+	}
+
+	images, err := s.pestImagesRepo.GetAllByPestIds(ctx, pestIds)
+	if err != nil {
+		log.Error("failed to get pest images", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	statuses, err := s.statusRepo.GetMap(ctx)
+	if err != nil {
+		log.Error("failed to get statuses", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	authors, err := s.userClient.GetByIds(ctx, authorIds)
+	if err != nil {
+		log.Error("failed to get authors", slog.String("error", err.Error()))
+	}
+
+	pests := make([]model.Pest, 0, len(repoPests))
+	for _, repoPest := range repoPests {
+		var author *model.User
+		if repoPest.Author != nil {
+			author = authors[*repoPest.Author]
+		}
+
+		pests = append(pests, *converter.ToPest(&repoPest, images[repoPest.ID], statuses[repoPest.Status], author))
+	}
+
+	return pests, nil
+}
+
+// GetById returns the pest along with the crops it affects and the articles on treating it.
+func (s *pestService) GetById(ctx context.Context, id int) (*model.Pest, error) {
+	const op = "pestService.GetById"
+	log := s.log.With(slog.String("op", op))
+
+	var pest *model.Pest
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		repoPest, err := s.pestRepo.GetById(ctx, id)
+		if err != nil {
+			log.Error("failed to get pest", slog.String("error", err.Error()))
+			if errors.Is(err, pestRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		images, err := s.pestImagesRepo.GetAll(ctx, id)
+		if err != nil {
+			log.Error("failed to get pest images", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+
+		repoStatus, err := s.statusRepo.GetById(ctx, repoPest.Status)
+		if err != nil {
+			log.Error("failed to get pest status", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+
+		var author *model.User
+		if repoPest.Author != nil {
+			author, err = s.userClient.GetById(ctx, *repoPest.Author)
+			if err != nil {
+				log.Error("failed to get author", slog.String("error", err.Error()))
+				return ErrInternalServerError
+			}
+		}
+
+		crops, err := s.pestRelationsRepo.GetCrops(ctx, id)
+		if err != nil {
+			log.Error("failed to get pest crops", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+
+		articles, err := s.pestRelationsRepo.GetArticles(ctx, id)
+		if err != nil {
+			log.Error("failed to get pest articles", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+
+		pest = converter.ToPest(repoPest, images, repoStatus.Status, author)
+		pest.Crops = converter.ToPestCrops(crops)
+		pest.Articles = converter.ToPestArticles(articles)
+
+		return nil
+	})
+
+	return pest, err
+}
+
+func (s *pestService) Update(ctx context.Context, id int, input *model.UpdatePestInput) error {
+	const op = "pestService.Update"
+	log := s.log.With(slog.String("op", op))
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		before, err := s.snapshots.Pest(ctx, id)
+		if err != nil {
+			log.Error("failed to get pest", slog.String("error", err.Error()))
+			if errors.Is(err, pestRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+		if err = s.policy.CanModify(ctx, before.Author, before.Status); err != nil {
+			log.Error("access check failed", slog.String("error", err.Error()))
+			return ErrAccessDenied
+		}
+
+		var statusId *int
+		if input.Status != nil {
+			newStatusId, err := s.workflow.Transition(ctx, before.Status, *input.Status)
+			if err != nil {
+				log.Error("failed to change pest status", slog.String("error", err.Error()))
+				return workflow.MapError(err)
+			}
+			statusId = &newStatusId
+		}
+
+		if err = s.pestRepo.Update(ctx, id, converter.ToRepoPestUpdateInput(input, statusId)); err != nil {
+			log.Error("failed to update pest", slog.String("error", err.Error()))
+			if errors.Is(err, pestRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+			if errors.Is(err, pestRepo.ErrAlreadyExists) {
+				return ErrAlreadyExists
+			}
+
+			return ErrInternalServerError
+		}
+
+		// An empty list removes every image, a missing one keeps them.
+		if input.ImageIds != nil {
+			if err = s.pestImagesRepo.DeleteBulk(ctx, id); err != nil {
+				log.Error("failed to delete pest images", slog.String("error", err.Error()))
+				return ErrInternalServerError
+			}
+		}
+		if len(input.ImageIds) > 0 {
+			if err = s.pestImagesRepo.CreateBulk(ctx, id, input.ImageIds); err != nil {
+				log.Error("failed to create pest images", slog.String("error", err.Error()))
+				return imagesErr(err)
+			}
+		}
+
+		after, err := s.snapshots.Pest(ctx, id)
+		if err != nil {
+			log.Error("failed to get updated pest", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+
+		return s.audit.Record(ctx, model.EntityPest, id, model.AuditActionUpdate, before, after)
+	})
+}
+
+func (s *pestService) Delete(ctx context.Context, id int) error {
+	const op = "pestService.Delete"
+	log := s.log.With(slog.String("op", op))
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		before, err := s.snapshots.Pest(ctx, id)
+		if err != nil {
+			log.Error("failed to get pest", slog.String("error", err.Error()))
+			if errors.Is(err, pestRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+		if err = s.policy.CanModify(ctx, before.Author, before.Status); err != nil {
+			log.Error("access check failed", slog.String("error", err.Error()))
+			return ErrAccessDenied
+		}
+
+		if err = s.pestRepo.Delete(ctx, id); err != nil {
+			log.Error("failed to delete pest", slog.String("error", err.Error()))
+			if errors.Is(err, pestRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		return s.audit.Record(ctx, model.EntityPest, id, model.AuditActionDelete, before, nil)
+	})
+}
+
+func imagesErr(err error) error {
+	if errors.Is(err, pestImagesRepo.ErrInvalidArguments) {
+		return ErrMediaNotFound
+	}
+	return ErrInternalServerError
+}
@@ -0,0 +1,78 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	authService "github.com/nogavadu/articles-service/internal/clients/auth-service/grpc"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/apperr"
+	"github.com/nogavadu/articles-service/internal/lib/identity"
+	"github.com/nogavadu/articles-service/internal/service"
+	"log/slog"
+)
+
+var (
+	ErrAccessDenied = apperr.New(apperr.ErrAccessDenied, "access denied")
+)
+
+type accessPolicy struct {
+	log *slog.Logger
+
+	accessClient *authService.AccessServiceClient
+	authClient   *authService.AuthServiceClient
+}
+
+func New(
+	log *slog.Logger,
+	accessClient *authService.AccessServiceClient,
+	authClient *authService.AuthServiceClient,
+) service.AccessPolicy {
+	return &accessPolicy{
+		log:          log,
+		accessClient: accessClient,
+		authClient:   authClient,
+	}
+}
+
+// CanModify lets authors edit and trash their own items while they are in review,
+// anything else needs a moderator.
+func (p *accessPolicy) CanModify(ctx context.Context, author *model.User, status string) error {
+	const op = "accessPolicy.CanModify"
+	log := p.log.With(slog.String("op", op))
+
+	if author != nil && status == model.StatusReview {
+		if userId, ok := identity.UserId(ctx); ok && userId == author.Id {
+			return nil
+		}
+	}
+
+	token, err := p.authClient.AccessToken(ctx)
+	if err != nil {
+		log.Error("failed to get access token", slog.String("error", err.Error()))
+		return fmt.Errorf("%w: %w", ErrAccessDenied, err)
+	}
+
+	if err = p.accessClient.Check(ctx, token, authService.ModeratorAccessLevel); err != nil {
+		return fmt.Errorf("%w: %w", ErrAccessDenied, err)
+	}
+
+	return nil
+}
+
+// CanHardDelete guards operations that remove data for good.
+func (p *accessPolicy) CanHardDelete(ctx context.Context) error {
+	const op = "accessPolicy.CanHardDelete"
+	log := p.log.With(slog.String("op", op))
+
+	token, err := p.authClient.AccessToken(ctx)
+	if err != nil {
+		log.Error("failed to get access token", slog.String("error", err.Error()))
+		return fmt.Errorf("%w: %w", ErrAccessDenied, err)
+	}
+
+	if err = p.accessClient.Check(ctx, token, authService.AdminAccessLevel); err != nil {
+		return fmt.Errorf("%w: %w", ErrAccessDenied, err)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"github.com/nogavadu/articles-service/internal/domain/model"
+	"io"
 )
 
 type AuthService interface {
@@ -18,30 +19,125 @@ type UserService interface {
 }
 
 type CropService interface {
-	Create(ctx context.Context, userId int, cropInfo *model.CropInfo) (int, error)
+	Create(ctx context.Context, cropInfo *model.CropInfo) (int, error)
 	GetAll(ctx context.Context, params *model.CropGetAllParams) ([]model.Crop, error)
 	GetById(ctx context.Context, id int) (*model.Crop, error)
 	Update(ctx context.Context, id int, input *model.UpdateCropInput) error
+	SetParent(ctx context.Context, id int, input *model.CropParentInput) error
 	Delete(ctx context.Context, id int) error
 
 	AddRelation(ctx context.Context, cropId int, categoryId int) error
 	RemoveRelation(ctx context.Context, cropId int, categoryId int) error
 }
 
+type CalendarService interface {
+	Create(ctx context.Context, cropId int, info *model.CalendarEntryInfo) (int, error)
+	GetAll(ctx context.Context, cropId int) ([]model.CalendarEntry, error)
+	Update(ctx context.Context, cropId int, id int, input *model.UpdateCalendarEntryInput) error
+	Delete(ctx context.Context, cropId int, id int) error
+	GetByZone(ctx context.Context, params *model.CalendarZoneParams) ([]model.CalendarZoneEntry, error)
+}
+
+type CompanionService interface {
+	GetAll(ctx context.Context, cropId int) ([]model.CropCompanion, error)
+	Set(ctx context.Context, cropId int, companionId int, info *model.CropCompanionInfo) error
+	Delete(ctx context.Context, cropId int, companionId int) error
+	CheckBed(ctx context.Context, input *model.BedCheckInput) ([]model.BedConflict, error)
+}
+
 type CategoryService interface {
-	Create(ctx context.Context, userId int, category *model.CategoryInfo, params *model.CategoryCreateParams) (int, error)
+	Create(ctx context.Context, category *model.CategoryInfo, params *model.CategoryCreateParams) (int, error)
 	GetAll(ctx context.Context, params *model.CategoryGetAllParams) ([]model.Category, error)
 	GetById(ctx context.Context, id int) (*model.Category, error)
 	Update(ctx context.Context, id int, input *model.UpdateCategoryInput) error
+	Move(ctx context.Context, id int, input *model.CategoryMoveInput) error
 	Delete(ctx context.Context, id int) error
 }
 
 type ArticleService interface {
-	Create(ctx context.Context, userId int, cropId int, categoryId int, articleBody *model.ArticleBody) (int, error)
-	GetAll(ctx context.Context, params *model.ArticleGetAllParams) ([]model.Article, error)
+	Create(ctx context.Context, relations []model.ArticleRelation, articleBody *model.ArticleBody) (int, error)
+	GetAll(ctx context.Context, params *model.ArticleGetAllParams) (*model.ArticleList, error)
 	GetById(ctx context.Context, id int) (*model.Article, error)
 	Update(ctx context.Context, id int, input *model.ArticleUpdateInput) error
 	Delete(ctx context.Context, id int) error
+
+	GetRevisions(ctx context.Context, articleId int) ([]model.ArticleRevision, error)
+	GetRevision(ctx context.Context, articleId int, revisionId int) (*model.ArticleRevision, error)
+	DiffRevisions(ctx context.Context, articleId int, fromId int, toId int) (*model.ArticleRevisionDiff, error)
+	RestoreRevision(ctx context.Context, articleId int, revisionId int) error
+
+	GetRelations(ctx context.Context, articleId int) ([]model.ArticleRelation, error)
+	AddRelation(ctx context.Context, articleId int, relation *model.ArticleRelation) error
+	RemoveRelation(ctx context.Context, articleId int, relation *model.ArticleRelation) error
+	GetInconsistentRelations(ctx context.Context) ([]model.InconsistentArticleRelation, error)
+
+	AddImage(ctx context.Context, articleId int, input *model.ArticleImageInput) (int, error)
+	UpdateImage(ctx context.Context, articleId int, imageId int, input *model.ArticleImageDetails) error
+	ReorderImages(ctx context.Context, articleId int, imageIds []int) error
+	RemoveImage(ctx context.Context, articleId int, imageId int) error
+
+	RenderPending(ctx context.Context) (int, error)
+}
+
+type PestService interface {
+	Create(ctx context.Context, info *model.PestInfo) (int, error)
+	GetAll(ctx context.Context, params *model.PestGetAllParams) ([]model.Pest, error)
+	GetById(ctx context.Context, id int) (*model.Pest, error)
+	Update(ctx context.Context, id int, input *model.UpdatePestInput) error
+	Delete(ctx context.Context, id int) error
+
+	AddCrop(ctx context.Context, pestId int, cropId int) error
+	RemoveCrop(ctx context.Context, pestId int, cropId int) error
+	AddArticle(ctx context.Context, pestId int, articleId int) error
+	RemoveArticle(ctx context.Context, pestId int, articleId int) error
+}
+
+type MediaService interface {
+	Upload(ctx context.Context, r io.Reader) (*model.Media, error)
+	GetById(ctx context.Context, id int) (*model.Media, error)
+	Open(ctx context.Context, key string) (*model.MediaFile, io.ReadCloser, error)
+}
+
+type ModerationService interface {
+	GetQueue(ctx context.Context) ([]model.ModerationItem, error)
+	Approve(ctx context.Context, entityType string, id int) error
+	Reject(ctx context.Context, entityType string, id int, reason string) error
+}
+
+type TrashService interface {
+	GetAll(ctx context.Context) ([]model.TrashItem, error)
+	Restore(ctx context.Context, entityType string, id int) error
+	Delete(ctx context.Context, entityType string, id int) error
+	Purge(ctx context.Context) (int, error)
+}
+
+// AccessPolicy decides who may change or remove crops, categories, articles and pests.
+type AccessPolicy interface {
+	CanModify(ctx context.Context, author *model.User, status string) error
+	CanHardDelete(ctx context.Context) error
+}
+
+// AuditService keeps a trail of every write made to crops, categories, articles and pests.
+type AuditService interface {
+	Record(ctx context.Context, entityType string, entityId int, action string, before, after any) error
+	GetAll(ctx context.Context, params *model.AuditGetAllParams) ([]model.AuditEntry, error)
+}
+
+// SnapshotLoader loads crops, categories, articles and pests for the before and after images of the audit trail.
+type SnapshotLoader interface {
+	Entity(ctx context.Context, entityType string, id int) (any, error)
+	Crop(ctx context.Context, id int) (*model.Crop, error)
+	Category(ctx context.Context, id int) (*model.Category, error)
+	Article(ctx context.Context, id int) (*model.Article, error)
+	Pest(ctx context.Context, id int) (*model.Pest, error)
+}
+
+// StatusWorkflow resolves status ids for crops, categories, articles and pests and enforces allowed status transitions.
+type StatusWorkflow interface {
+	InitialStatus(ctx context.Context, status string) (int, error)
+	Transition(ctx context.Context, from string, to string) (int, error)
+	// ListStatus resolves the status filter of a list, defaultStatus is used when it is not set.
+	ListStatus(ctx context.Context, status *string, defaultStatus string) (int, error)
 }
 
 type StatusService interface {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/apperr"
 	"github.com/nogavadu/articles-service/internal/repository"
 	"github.com/nogavadu/articles-service/internal/service"
 	"github.com/nogavadu/platform_common/pkg/db"
@@ -11,8 +12,8 @@ import (
 )
 
 var (
-	ErrAlreadyExists       = errors.New("article already exists")
-	ErrInvalidArguments    = errors.New("invalid article arguments")
+	ErrAlreadyExists       = apperr.New(apperr.ErrAlreadyExists, "status already exists")
+	ErrInvalidArguments    = apperr.New(apperr.ErrInvalidArguments, "invalid status arguments")
 	ErrInternalServerError = errors.New("internal server error")
 )
 
@@ -0,0 +1,287 @@
+package trash
+
+import (
+	"context"
+	"errors"
+	authService "github.com/nogavadu/articles-service/internal/clients/auth-service/grpc"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/apperr"
+	"github.com/nogavadu/articles-service/internal/repository"
+	articleRepo "github.com/nogavadu/articles-service/internal/repository/article"
+	categoryRepo "github.com/nogavadu/articles-service/internal/repository/category"
+	cropRepo "github.com/nogavadu/articles-service/internal/repository/crop"
+	pestRepo "github.com/nogavadu/articles-service/internal/repository/pest"
+	"github.com/nogavadu/articles-service/internal/service"
+	"github.com/nogavadu/platform_common/pkg/db"
+	"log/slog"
+	"sort"
+	"time"
+)
+
+var (
+	ErrNotFound            = apperr.New(apperr.ErrNotFound, "entity not found in trash")
+	ErrInvalidArguments    = apperr.New(apperr.ErrInvalidArguments, "invalid arguments")
+	ErrInternalServerError = errors.New("internal server error")
+	ErrAccessDenied        = apperr.New(apperr.ErrAccessDenied, "access denied")
+)
+
+type trashService struct {
+	log *slog.Logger
+
+	retention time.Duration
+
+	cropRepo     repository.CropRepository
+	categoryRepo repository.CategoryRepository
+	articleRepo  repository.ArticleRepository
+	pestRepo     repository.PestRepository
+	policy       service.AccessPolicy
+	audit        service.AuditService
+	snapshots    service.SnapshotLoader
+	txManager    db.TxManager
+
+	accessClient *authService.AccessServiceClient
+	authClient   *authService.AuthServiceClient
+	userClient   *authService.UserServiceClient
+}
+
+func New(
+	log *slog.Logger,
+	retention time.Duration,
+	cropRepo repository.CropRepository,
+	categoryRepo repository.CategoryRepository,
+	articleRepo repository.ArticleRepository,
+	pestRepo repository.PestRepository,
+	policy service.AccessPolicy,
+	audit service.AuditService,
+	snapshots service.SnapshotLoader,
+	txManager db.TxManager,
+	accessClient *authService.AccessServiceClient,
+	authClient *authService.AuthServiceClient,
+	userClient *authService.UserServiceClient,
+) service.TrashService {
+	return &trashService{
+		log:          log,
+		retention:    retention,
+		cropRepo:     cropRepo,
+		categoryRepo: categoryRepo,
+		articleRepo:  articleRepo,
+		pestRepo:     pestRepo,
+		policy:       policy,
+		audit:        audit,
+		snapshots:    snapshots,
+		txManager:    txManager,
+		accessClient: accessClient,
+		authClient:   authClient,
+		userClient:   userClient,
+	}
+}
+
+// GetAll lists trashed crops, categories, articles and pests, most recently deleted first.
+func (s *trashService) GetAll(ctx context.Context) ([]model.TrashItem, error) {
+	const op = "trashService.GetAll"
+	log := s.log.With(slog.String("op", op))
+
+	if err := s.checkAccess(ctx); err != nil {
+		log.Error("access check failed", slog.String("error", err.Error()))
+		return nil, ErrAccessDenied
+	}
+
+	crops, err := s.cropRepo.GetDeleted(ctx)
+	if err != nil {
+		log.Error("failed to get deleted crops", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	categories, err := s.categoryRepo.GetDeleted(ctx)
+	if err != nil {
+		log.Error("failed to get deleted categories", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	articles, err := s.articleRepo.GetDeleted(ctx)
+	if err != nil {
+		log.Error("failed to get deleted articles", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	pests, err := s.pestRepo.GetDeleted(ctx)
+	if err != nil {
+		log.Error("failed to get deleted pests", slog.String("error", err.Error()))
+		return nil, ErrInternalServerError
+	}
+
+	items := make([]model.TrashItem, 0, len(crops)+len(categories)+len(articles)+len(pests))
+	authorIds := make([]*int, 0, cap(items))
+	for _, c := range crops {
+		items = append(items, model.TrashItem{EntityType: model.EntityCrop, EntityId: c.ID, Title: c.Name, DeletedAt: *c.DeletedAt})
+		authorIds = append(authorIds, c.Author)
+	}
+	for _, c := range categories {
+		items = append(items, model.TrashItem{EntityType: model.EntityCategory, EntityId: c.ID, Title: c.Name, DeletedAt: *c.DeletedAt})
+		authorIds = append(authorIds, c.Author)
+	}
+	for _, a := range articles {
+		items = append(items, model.TrashItem{EntityType: model.EntityArticle, EntityId: a.Id, Title: a.Title, DeletedAt: *a.DeletedAt})
+		authorIds = append(authorIds, a.Author)
+	}
+	for _, p := range pests {
+		items = append(items, model.TrashItem{EntityType: model.EntityPest, EntityId: p.ID, Title: p.Name, DeletedAt: *p.DeletedAt})
+		authorIds = append(authorIds, p.Author)
+	}
+
+	ids := make([]int, 0, len(authorIds))
+	for _, id := range authorIds {
+		if id != nil {
+			ids = append(ids, *id)
+		}
+	}
+	authors, err := s.userClient.GetByIds(ctx, ids)
+	if err != nil {
+		log.Error("failed to get authors", slog.String("error", err.Error()))
+	}
+
+	for i := range items {
+		if authorIds[i] != nil {
+			items[i].Author = authors[*authorIds[i]]
+		}
+		items[i].PurgeAt = items[i].DeletedAt.Add(s.retention)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].DeletedAt.After(items[j].DeletedAt)
+	})
+
+	return items, nil
+}
+
+// Restore takes an entity out of the trash, its relations were never removed so they come back with it.
+func (s *trashService) Restore(ctx context.Context, entityType string, id int) error {
+	const op = "trashService.Restore"
+	log := s.log.With(slog.String("op", op))
+
+	if err := s.checkAccess(ctx); err != nil {
+		log.Error("access check failed", slog.String("error", err.Error()))
+		return ErrAccessDenied
+	}
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var err error
+		switch entityType {
+		case model.EntityCrop:
+			err = s.cropRepo.Restore(ctx, id)
+		case model.EntityCategory:
+			err = s.categoryRepo.Restore(ctx, id)
+		case model.EntityArticle:
+			err = s.articleRepo.Restore(ctx, id)
+		case model.EntityPest:
+			err = s.pestRepo.Restore(ctx, id)
+		default:
+			return ErrInvalidArguments
+		}
+		if err != nil {
+			log.Error("failed to restore", slog.String("entity", entityType), slog.Int("id", id), slog.String("error", err.Error()))
+			if errors.Is(err, cropRepo.ErrNotFound) || errors.Is(err, categoryRepo.ErrNotFound) || errors.Is(err, articleRepo.ErrNotFound) || errors.Is(err, pestRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		after, err := s.snapshots.Entity(ctx, entityType, id)
+		if err != nil {
+			log.Error("failed to get restored entity", slog.String("error", err.Error()))
+			return ErrInternalServerError
+		}
+
+		return s.audit.Record(ctx, entityType, id, model.AuditActionRestore, nil, after)
+	})
+}
+
+// Delete permanently removes a single trashed entity ahead of the retention period.
+func (s *trashService) Delete(ctx context.Context, entityType string, id int) error {
+	const op = "trashService.Delete"
+	log := s.log.With(slog.String("op", op))
+
+	if err := s.policy.CanHardDelete(ctx); err != nil {
+		log.Error("access check failed", slog.String("error", err.Error()))
+		return ErrAccessDenied
+	}
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var err error
+		switch entityType {
+		case model.EntityCrop:
+			err = s.cropRepo.HardDelete(ctx, id)
+		case model.EntityCategory:
+			err = s.categoryRepo.HardDelete(ctx, id)
+		case model.EntityArticle:
+			err = s.articleRepo.HardDelete(ctx, id)
+		case model.EntityPest:
+			err = s.pestRepo.HardDelete(ctx, id)
+		default:
+			return ErrInvalidArguments
+		}
+		if err != nil {
+			log.Error("failed to delete", slog.String("entity", entityType), slog.Int("id", id), slog.String("error", err.Error()))
+			if errors.Is(err, cropRepo.ErrNotFound) || errors.Is(err, categoryRepo.ErrNotFound) || errors.Is(err, articleRepo.ErrNotFound) || errors.Is(err, pestRepo.ErrNotFound) {
+				return ErrNotFound
+			}
+
+			return ErrInternalServerError
+		}
+
+		return s.audit.Record(ctx, entityType, id, model.AuditActionPurge, nil, nil)
+	})
+}
+
+// Purge permanently removes everything that has been in the trash longer than the retention period.
+func (s *trashService) Purge(ctx context.Context) (int, error) {
+	const op = "trashService.Purge"
+	log := s.log.With(slog.String("op", op))
+
+	deletedBefore := time.Now().Add(-s.retention)
+
+	var purged int
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		purgers := []struct {
+			entityType string
+			purge      func(ctx context.Context, deletedBefore time.Time) ([]int, error)
+		}{
+			{model.EntityPest, s.pestRepo.Purge},
+			{model.EntityArticle, s.articleRepo.Purge},
+			{model.EntityCategory, s.categoryRepo.Purge},
+			{model.EntityCrop, s.cropRepo.Purge},
+		}
+
+		for _, p := range purgers {
+			ids, err := p.purge(ctx, deletedBefore)
+			if err != nil {
+				log.Error("failed to purge", slog.String("entity", p.entityType), slog.String("error", err.Error()))
+				return ErrInternalServerError
+			}
+
+			for _, id := range ids {
+				if err = s.audit.Record(ctx, p.entityType, id, model.AuditActionPurge, nil, nil); err != nil {
+					return err
+				}
+			}
+			purged += len(ids)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return purged, nil
+}
+
+func (s *trashService) checkAccess(ctx context.Context) error {
+	token, err := s.authClient.AccessToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.accessClient.Check(ctx, token, authService.ModeratorAccessLevel)
+}
@@ -5,15 +5,16 @@ import (
 	"errors"
 	"github.com/nogavadu/articles-service/internal/clients/auth-service/grpc"
 	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/apperr"
 	"github.com/nogavadu/articles-service/internal/service"
 	"log/slog"
 )
 
 var (
-	ErrAlreadyExists       = errors.New("article already exists")
-	ErrInvalidArguments    = errors.New("invalid article arguments")
+	ErrAlreadyExists       = apperr.New(apperr.ErrAlreadyExists, "user already exists")
+	ErrInvalidArguments    = apperr.New(apperr.ErrInvalidArguments, "invalid user arguments")
 	ErrInternalServerError = errors.New("internal server error")
-	ErrAccessDenied        = errors.New("access denied")
+	ErrAccessDenied        = apperr.New(apperr.ErrAccessDenied, "access denied")
 )
 
 type userService struct {
@@ -0,0 +1,183 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	authService "github.com/nogavadu/articles-service/internal/clients/auth-service/grpc"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	"github.com/nogavadu/articles-service/internal/lib/apperr"
+	"github.com/nogavadu/articles-service/internal/repository"
+	"github.com/nogavadu/articles-service/internal/service"
+	"log/slog"
+)
+
+var (
+	ErrIllegalTransition   = apperr.New(apperr.ErrIllegalTransition, "illegal status transition")
+	ErrUnknownStatus       = apperr.New(apperr.ErrInvalidArguments, "unknown status")
+	ErrAccessDenied        = apperr.New(apperr.ErrAccessDenied, "access denied")
+	ErrInternalServerError = errors.New("internal server error")
+)
+
+type transition struct {
+	from string
+	to   string
+}
+
+// transitions lists every allowed status change of crops, categories, articles and pests
+// together with the access level it requires.
+var transitions = map[transition]int{
+	{model.StatusReview, model.StatusPublished}:   authService.ModeratorAccessLevel,
+	{model.StatusReview, model.StatusCanceled}:    authService.ModeratorAccessLevel,
+	{model.StatusPublished, model.StatusReview}:   authService.ModeratorAccessLevel,
+	{model.StatusPublished, model.StatusCanceled}: authService.ModeratorAccessLevel,
+	{model.StatusCanceled, model.StatusReview}:    authService.UserAccessLevel,
+	{model.StatusCanceled, model.StatusPublished}: authService.ModeratorAccessLevel,
+}
+
+// initialStatuses lists statuses an entity can be created with.
+var initialStatuses = map[string]int{
+	model.StatusReview:    authService.UserAccessLevel,
+	model.StatusPublished: authService.ModeratorAccessLevel,
+}
+
+const defaultInitialStatus = model.StatusReview
+
+type statusWorkflow struct {
+	log *slog.Logger
+
+	statusRepo repository.StatusRepository
+
+	accessClient *authService.AccessServiceClient
+	authClient   *authService.AuthServiceClient
+}
+
+func New(
+	log *slog.Logger,
+	statusRepo repository.StatusRepository,
+	accessClient *authService.AccessServiceClient,
+	authClient *authService.AuthServiceClient,
+) service.StatusWorkflow {
+	return &statusWorkflow{
+		log:          log,
+		statusRepo:   statusRepo,
+		accessClient: accessClient,
+		authClient:   authClient,
+	}
+}
+
+func (w *statusWorkflow) InitialStatus(ctx context.Context, status string) (int, error) {
+	const op = "statusWorkflow.InitialStatus"
+	log := w.log.With(slog.String("op", op))
+
+	if status == "" {
+		status = defaultInitialStatus
+	}
+
+	statusId, err := w.statusId(ctx, status)
+	if err != nil {
+		return 0, err
+	}
+
+	level, ok := initialStatuses[status]
+	if !ok {
+		log.Warn("entity can not be created with status", slog.String("status", status))
+		return 0, ErrIllegalTransition
+	}
+
+	if err = w.checkAccess(ctx, level); err != nil {
+		return 0, err
+	}
+
+	return statusId, nil
+}
+
+func (w *statusWorkflow) Transition(ctx context.Context, from string, to string) (int, error) {
+	const op = "statusWorkflow.Transition"
+	log := w.log.With(slog.String("op", op))
+
+	statuses, err := w.statusRepo.GetMap(ctx)
+	if err != nil {
+		log.Error("failed to get statuses", slog.String("error", err.Error()))
+		return 0, ErrInternalServerError
+	}
+
+	toStatusId, ok := findStatus(statuses, to)
+	if !ok {
+		return 0, ErrUnknownStatus
+	}
+
+	if from == to {
+		return toStatusId, nil
+	}
+
+	level, ok := transitions[transition{from: from, to: to}]
+	if !ok {
+		log.Warn("illegal status transition", slog.String("from", from), slog.String("to", to))
+		return 0, ErrIllegalTransition
+	}
+
+	if err = w.checkAccess(ctx, level); err != nil {
+		return 0, err
+	}
+
+	return toStatusId, nil
+}
+
+func (w *statusWorkflow) ListStatus(ctx context.Context, status *string, defaultStatus string) (int, error) {
+	if status == nil {
+		return w.statusId(ctx, defaultStatus)
+	}
+
+	return w.statusId(ctx, *status)
+}
+
+func (w *statusWorkflow) statusId(ctx context.Context, status string) (int, error) {
+	statuses, err := w.statusRepo.GetMap(ctx)
+	if err != nil {
+		w.log.Error("failed to get statuses", slog.String("error", err.Error()))
+		return 0, ErrInternalServerError
+	}
+
+	id, ok := findStatus(statuses, status)
+	if !ok {
+		return 0, ErrUnknownStatus
+	}
+
+	return id, nil
+}
+
+func findStatus(statuses map[int]string, status string) (int, bool) {
+	for id, s := range statuses {
+		if s == status {
+			return id, true
+		}
+	}
+
+	return 0, false
+}
+
+func (w *statusWorkflow) checkAccess(ctx context.Context, level int) error {
+	token, err := w.authClient.AccessToken(ctx)
+	if err != nil {
+		w.log.Error("failed to get access token", slog.String("error", err.Error()))
+		return ErrAccessDenied
+	}
+
+	if err = w.accessClient.Check(ctx, token, level); err != nil {
+		w.log.Error("access check failed", slog.String("error", err.Error()))
+		return ErrAccessDenied
+	}
+
+	return nil
+}
+
+// MapError passes the workflow errors through to the services, they already carry their kinds,
+// anything else is reported as an internal error.
+func MapError(err error) error {
+	switch {
+	case errors.Is(err, ErrAccessDenied), errors.Is(err, ErrIllegalTransition), errors.Is(err, ErrUnknownStatus):
+		return err
+	default:
+		return ErrInternalServerError
+	}
+}
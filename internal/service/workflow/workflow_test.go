@@ -0,0 +1,196 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	authService "github.com/nogavadu/articles-service/internal/clients/auth-service/grpc"
+	"github.com/nogavadu/articles-service/internal/domain/model"
+	statusRepoModel "github.com/nogavadu/articles-service/internal/repository/status/model"
+	"io"
+	"log/slog"
+	"testing"
+)
+
+const (
+	publishedId = 1
+	reviewId    = 2
+	canceledId  = 3
+)
+
+type statusRepo struct{}
+
+func (statusRepo) Create(context.Context, string) (int, error) {
+	return 0, errors.New("not implemented")
+}
+
+func (statusRepo) GetAll(context.Context) ([]statusRepoModel.Status, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (statusRepo) GetMap(context.Context) (map[int]string, error) {
+	return map[int]string{
+		publishedId: model.StatusPublished,
+		reviewId:    model.StatusReview,
+		canceledId:  model.StatusCanceled,
+	}, nil
+}
+
+func (statusRepo) GetByStatus(context.Context, string) (*statusRepoModel.Status, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (statusRepo) GetById(context.Context, int) (*statusRepoModel.Status, error) {
+	return nil, errors.New("not implemented")
+}
+
+// newWorkflow has no auth clients, so the tests only reach the paths that return before the access check.
+func newWorkflow() *statusWorkflow {
+	return &statusWorkflow{
+		log:        slog.New(slog.NewTextHandler(io.Discard, nil)),
+		statusRepo: statusRepo{},
+	}
+}
+
+func TestTransitions(t *testing.T) {
+	statuses := []string{model.StatusPublished, model.StatusReview, model.StatusCanceled}
+	want := map[transition]int{
+		{model.StatusReview, model.StatusPublished}:   authService.ModeratorAccessLevel,
+		{model.StatusReview, model.StatusCanceled}:    authService.ModeratorAccessLevel,
+		{model.StatusPublished, model.StatusReview}:   authService.ModeratorAccessLevel,
+		{model.StatusPublished, model.StatusCanceled}: authService.ModeratorAccessLevel,
+		{model.StatusCanceled, model.StatusReview}:    authService.UserAccessLevel,
+		{model.StatusCanceled, model.StatusPublished}: authService.ModeratorAccessLevel,
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			t.Run(from+"->"+to, func(t *testing.T) {
+				level, ok := transitions[transition{from: from, to: to}]
+				wantLevel, wantOk := want[transition{from: from, to: to}]
+				if ok != wantOk || level != wantLevel {
+					t.Errorf("transitions[%s->%s] = %d, %t, want %d, %t", from, to, level, ok, wantLevel, wantOk)
+				}
+			})
+		}
+	}
+}
+
+func TestInitialStatuses(t *testing.T) {
+	tests := []struct {
+		status string
+		level  int
+		ok     bool
+	}{
+		{status: model.StatusReview, level: authService.UserAccessLevel, ok: true},
+		{status: model.StatusPublished, level: authService.ModeratorAccessLevel, ok: true},
+		{status: model.StatusCanceled, ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			level, ok := initialStatuses[tt.status]
+			if ok != tt.ok || level != tt.level {
+				t.Errorf("initialStatuses[%s] = %d, %t, want %d, %t", tt.status, level, ok, tt.level, tt.ok)
+			}
+		})
+	}
+}
+
+func TestTransition(t *testing.T) {
+	tests := []struct {
+		name   string
+		from   string
+		to     string
+		wantId int
+		err    error
+	}{
+		{name: "same status is a no-op", from: model.StatusReview, to: model.StatusReview, wantId: reviewId},
+		{name: "same published status", from: model.StatusPublished, to: model.StatusPublished, wantId: publishedId},
+		{name: "unknown status", from: model.StatusReview, to: "archived", err: ErrUnknownStatus},
+		{name: "empty status", from: model.StatusReview, to: "", err: ErrUnknownStatus},
+		{name: "same canceled status", from: model.StatusCanceled, to: model.StatusCanceled, wantId: canceledId},
+	}
+
+	w := newWorkflow()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := w.Transition(context.Background(), tt.from, tt.to)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Transition() error = %v, want %v", err, tt.err)
+			}
+			if id != tt.wantId {
+				t.Errorf("Transition() = %d, want %d", id, tt.wantId)
+			}
+		})
+	}
+}
+
+func TestInitialStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		status string
+		err    error
+	}{
+		{name: "unknown status", status: "archived", err: ErrUnknownStatus},
+		{name: "canceled is not initial", status: model.StatusCanceled, err: ErrIllegalTransition},
+	}
+
+	w := newWorkflow()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := w.InitialStatus(context.Background(), tt.status); !errors.Is(err, tt.err) {
+				t.Errorf("InitialStatus(%q) error = %v, want %v", tt.status, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestListStatus(t *testing.T) {
+	canceled, unknown := model.StatusCanceled, "archived"
+	tests := []struct {
+		name          string
+		status        *string
+		defaultStatus string
+		wantId        int
+		err           error
+	}{
+		{name: "default review", defaultStatus: model.StatusReview, wantId: reviewId},
+		{name: "default published", defaultStatus: model.StatusPublished, wantId: publishedId},
+		{name: "explicit status wins", status: &canceled, defaultStatus: model.StatusReview, wantId: canceledId},
+		{name: "unknown status", status: &unknown, defaultStatus: model.StatusReview, err: ErrUnknownStatus},
+	}
+
+	w := newWorkflow()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := w.ListStatus(context.Background(), tt.status, tt.defaultStatus)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ListStatus() error = %v, want %v", err, tt.err)
+			}
+			if id != tt.wantId {
+				t.Errorf("ListStatus() = %d, want %d", id, tt.wantId)
+			}
+		})
+	}
+}
+
+func TestMapError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "access denied", err: ErrAccessDenied, want: ErrAccessDenied},
+		{name: "illegal transition", err: ErrIllegalTransition, want: ErrIllegalTransition},
+		{name: "unknown status", err: ErrUnknownStatus, want: ErrUnknownStatus},
+		{name: "anything else", err: errors.New("connection refused"), want: ErrInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MapError(tt.err); got != tt.want {
+				t.Errorf("MapError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS articles_created_at_id_idx ON articles (created_at, id);
+CREATE INDEX IF NOT EXISTS articles_updated_at_id_idx ON articles (updated_at, id);
+CREATE INDEX IF NOT EXISTS articles_title_id_idx ON articles (title, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS articles_created_at_id_idx;
+DROP INDEX IF EXISTS articles_updated_at_id_idx;
+DROP INDEX IF EXISTS articles_title_id_idx;
+-- +goose StatementEnd
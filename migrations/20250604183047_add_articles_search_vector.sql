@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE articles
+    ADD COLUMN IF NOT EXISTS search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('russian', coalesce(latin_name, '')), 'B') ||
+        setweight(to_tsvector('russian', coalesce(text, '')), 'C')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS articles_search_vector_idx ON articles USING GIN (search_vector);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS articles_search_vector_idx;
+
+ALTER TABLE articles
+    DROP COLUMN IF EXISTS search_vector;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS article_revisions
+(
+    id         SERIAL PRIMARY KEY,
+    article_id INT       NOT NULL REFERENCES articles (id) ON DELETE CASCADE,
+    title      VARCHAR   NOT NULL,
+    latin_name VARCHAR,
+    text       TEXT,
+    author     INT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS article_revisions_article_id_idx ON article_revisions (article_id, id);
+
+INSERT INTO article_revisions (article_id, title, latin_name, text, author, created_at)
+SELECT id, title, latin_name, text, author, updated_at
+FROM articles;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS article_revisions;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE crops
+    ADD COLUMN IF NOT EXISTS rejection_reason VARCHAR;
+ALTER TABLE categories
+    ADD COLUMN IF NOT EXISTS rejection_reason VARCHAR;
+ALTER TABLE articles
+    ADD COLUMN IF NOT EXISTS rejection_reason VARCHAR;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE crops
+    DROP COLUMN IF EXISTS rejection_reason;
+ALTER TABLE categories
+    DROP COLUMN IF EXISTS rejection_reason;
+ALTER TABLE articles
+    DROP COLUMN IF EXISTS rejection_reason;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE crops
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+ALTER TABLE categories
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+ALTER TABLE articles
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS crops_deleted_at_idx ON crops (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS categories_deleted_at_idx ON categories (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS articles_deleted_at_idx ON articles (deleted_at) WHERE deleted_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM articles WHERE deleted_at IS NOT NULL;
+DELETE FROM categories WHERE deleted_at IS NOT NULL;
+DELETE FROM crops WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE crops
+    DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE categories
+    DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE articles
+    DROP COLUMN IF EXISTS deleted_at;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- NOT VALID keeps the existing inconsistent rows, they are listed by GET /api/articles/relations/inconsistent
+-- and the constraint can be validated once they are fixed.
+ALTER TABLE articles_relations
+    ADD CONSTRAINT articles_relations_crop_category_fkey
+        FOREIGN KEY (crop_id, category_id) REFERENCES crops_categories (crop_id, category_id) NOT VALID;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE articles_relations
+    DROP CONSTRAINT articles_relations_crop_category_fkey;
+-- +goose StatementEnd
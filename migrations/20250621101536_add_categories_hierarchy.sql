@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE categories
+    ADD COLUMN IF NOT EXISTS parent_id INT REFERENCES categories (id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS position INT NOT NULL DEFAULT 0,
+    ADD CONSTRAINT categories_parent_id_check CHECK (parent_id <> id);
+
+CREATE INDEX IF NOT EXISTS categories_parent_id_idx ON categories (parent_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE categories
+    DROP CONSTRAINT IF EXISTS categories_parent_id_check,
+    DROP COLUMN IF EXISTS position,
+    DROP COLUMN IF EXISTS parent_id;
+-- +goose StatementEnd
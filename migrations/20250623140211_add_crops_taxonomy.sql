@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE crops
+    ADD COLUMN IF NOT EXISTS parent_id INT REFERENCES crops (id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS family VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS genus VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS species VARCHAR(255),
+    ADD CONSTRAINT crops_parent_id_check CHECK (parent_id <> id);
+
+CREATE INDEX IF NOT EXISTS crops_parent_id_idx ON crops (parent_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE crops
+    DROP CONSTRAINT IF EXISTS crops_parent_id_check,
+    DROP COLUMN IF EXISTS species,
+    DROP COLUMN IF EXISTS genus,
+    DROP COLUMN IF EXISTS family,
+    DROP COLUMN IF EXISTS parent_id;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS crop_calendar
+(
+    id          SERIAL PRIMARY KEY,
+    crop_id     INT         NOT NULL REFERENCES crops (id) ON DELETE CASCADE,
+    activity    VARCHAR(32) NOT NULL,
+    zone        VARCHAR(16) NOT NULL,
+    start_month SMALLINT    NOT NULL CHECK (start_month BETWEEN 1 AND 12),
+    end_month   SMALLINT    NOT NULL CHECK (end_month BETWEEN 1 AND 12),
+    notes       TEXT,
+    created_at  TIMESTAMP   NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMP   NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS crop_calendar_crop_id_idx ON crop_calendar (crop_id);
+CREATE INDEX IF NOT EXISTS crop_calendar_zone_idx ON crop_calendar (zone);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS crop_calendar;
+-- +goose StatementEnd
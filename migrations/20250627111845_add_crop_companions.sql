@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Each pair is stored once with the lower id first, the relation is symmetric.
+CREATE TABLE IF NOT EXISTS crop_companions
+(
+    crop_id      INT         NOT NULL REFERENCES crops (id) ON DELETE CASCADE,
+    companion_id INT         NOT NULL REFERENCES crops (id) ON DELETE CASCADE,
+    type         VARCHAR(16) NOT NULL,
+    note         TEXT,
+    created_at   TIMESTAMP   NOT NULL DEFAULT now(),
+    updated_at   TIMESTAMP   NOT NULL DEFAULT now(),
+    PRIMARY KEY (crop_id, companion_id),
+    CHECK (crop_id < companion_id)
+);
+
+CREATE INDEX IF NOT EXISTS crop_companions_companion_id_idx ON crop_companions (companion_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS crop_companions;
+-- +goose StatementEnd
@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS pests
+(
+    id               SERIAL PRIMARY KEY,
+    name             VARCHAR UNIQUE NOT NULL,
+    latin_name       VARCHAR,
+    type             VARCHAR(16)    NOT NULL,
+    symptoms         TEXT,
+    description      TEXT,
+    status           INT REFERENCES entity_status (id) ON DELETE SET DEFAULT DEFAULT (2),
+    author           INT,
+    rejection_reason VARCHAR,
+    created_at       TIMESTAMP      NOT NULL DEFAULT now(),
+    updated_at       TIMESTAMP      NOT NULL DEFAULT now(),
+    deleted_at       TIMESTAMP,
+    CHECK (type IN ('pest', 'disease'))
+);
+
+CREATE INDEX IF NOT EXISTS pests_deleted_at_idx ON pests (deleted_at) WHERE deleted_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS pests_images
+(
+    id      SERIAL PRIMARY KEY,
+    img     VARCHAR NOT NULL,
+    pest_id INT     NOT NULL REFERENCES pests (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS pests_crops
+(
+    pest_id INT NOT NULL REFERENCES pests (id) ON DELETE CASCADE,
+    crop_id INT NOT NULL REFERENCES crops (id) ON DELETE CASCADE,
+    PRIMARY KEY (pest_id, crop_id)
+);
+
+CREATE INDEX IF NOT EXISTS pests_crops_crop_id_idx ON pests_crops (crop_id);
+
+-- Treatment articles describing how to prevent or fight the pest.
+CREATE TABLE IF NOT EXISTS pests_articles
+(
+    pest_id    INT NOT NULL REFERENCES pests (id) ON DELETE CASCADE,
+    article_id INT NOT NULL REFERENCES articles (id) ON DELETE CASCADE,
+    PRIMARY KEY (pest_id, article_id)
+);
+
+CREATE INDEX IF NOT EXISTS pests_articles_article_id_idx ON pests_articles (article_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pests_articles;
+DROP TABLE IF EXISTS pests_crops;
+DROP TABLE IF EXISTS pests_images;
+DROP TABLE IF EXISTS pests;
+-- +goose StatementEnd
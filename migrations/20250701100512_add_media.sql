@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Uploaded files, deduplicated by the sha256 checksum of their content.
+-- The url is resolved by the storage backend when the file is stored.
+CREATE TABLE IF NOT EXISTS media
+(
+    id           SERIAL PRIMARY KEY,
+    key          VARCHAR UNIQUE NOT NULL,
+    url          VARCHAR        NOT NULL,
+    checksum     CHAR(64) UNIQUE NOT NULL,
+    content_type VARCHAR        NOT NULL,
+    size         BIGINT         NOT NULL,
+    author       INT,
+    created_at   TIMESTAMP      NOT NULL DEFAULT now()
+);
+
+-- The legacy url columns are kept for rows written before uploads existed.
+ALTER TABLE crops
+    ADD COLUMN IF NOT EXISTS img_id INT REFERENCES media (id) ON DELETE SET NULL;
+ALTER TABLE categories
+    ADD COLUMN IF NOT EXISTS icon_id INT REFERENCES media (id) ON DELETE SET NULL;
+
+ALTER TABLE articles_images
+    ADD COLUMN IF NOT EXISTS media_id INT REFERENCES media (id) ON DELETE CASCADE,
+    ALTER COLUMN img DROP NOT NULL;
+ALTER TABLE pests_images
+    ADD COLUMN IF NOT EXISTS media_id INT REFERENCES media (id) ON DELETE CASCADE,
+    ALTER COLUMN img DROP NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM articles_images WHERE img IS NULL;
+DELETE FROM pests_images WHERE img IS NULL;
+
+ALTER TABLE pests_images
+    DROP COLUMN IF EXISTS media_id,
+    ALTER COLUMN img SET NOT NULL;
+ALTER TABLE articles_images
+    DROP COLUMN IF EXISTS media_id,
+    ALTER COLUMN img SET NOT NULL;
+ALTER TABLE categories
+    DROP COLUMN IF EXISTS icon_id;
+ALTER TABLE crops
+    DROP COLUMN IF EXISTS img_id;
+
+DROP TABLE IF EXISTS media;
+-- +goose StatementEnd
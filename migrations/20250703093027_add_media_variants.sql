@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Dimensions are unknown for formats that can't be decoded, e.g. webp.
+ALTER TABLE media
+    ADD COLUMN IF NOT EXISTS width  INT,
+    ADD COLUMN IF NOT EXISTS height INT;
+
+-- Downscaled copies of an uploaded image generated on upload, one per variant name.
+CREATE TABLE IF NOT EXISTS media_variants
+(
+    media_id     INT            NOT NULL REFERENCES media (id) ON DELETE CASCADE,
+    name         VARCHAR(16)    NOT NULL,
+    key          VARCHAR UNIQUE NOT NULL,
+    url          VARCHAR        NOT NULL,
+    content_type VARCHAR        NOT NULL,
+    size         BIGINT         NOT NULL,
+    width        INT            NOT NULL,
+    height       INT            NOT NULL,
+    PRIMARY KEY (media_id, name)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS media_variants;
+
+ALTER TABLE media
+    DROP COLUMN IF EXISTS height,
+    DROP COLUMN IF EXISTS width;
+-- +goose StatementEnd
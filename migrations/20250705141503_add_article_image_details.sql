@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE articles_images
+    ADD COLUMN IF NOT EXISTS position    INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS caption     VARCHAR,
+    ADD COLUMN IF NOT EXISTS alt         VARCHAR,
+    ADD COLUMN IF NOT EXISTS attribution VARCHAR;
+
+-- Existing images keep the order they were added in.
+UPDATE articles_images AS i
+SET position = o.position
+FROM (SELECT id, ROW_NUMBER() OVER (PARTITION BY article_id ORDER BY id) - 1 AS position
+      FROM articles_images) AS o
+WHERE i.id = o.id;
+
+CREATE INDEX IF NOT EXISTS articles_images_article_id_position_idx ON articles_images (article_id, position);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS articles_images_article_id_position_idx;
+
+ALTER TABLE articles_images
+    DROP COLUMN IF EXISTS attribution,
+    DROP COLUMN IF EXISTS alt,
+    DROP COLUMN IF EXISTS caption,
+    DROP COLUMN IF EXISTS position;
+-- +goose StatementEnd
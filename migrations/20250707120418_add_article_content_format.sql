@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- html and toc are rendered from text by the service on write, existing articles are rendered on startup.
+ALTER TABLE articles
+    ADD COLUMN IF NOT EXISTS format VARCHAR(16) NOT NULL DEFAULT 'plain',
+    ADD COLUMN IF NOT EXISTS html   TEXT,
+    ADD COLUMN IF NOT EXISTS toc    JSONB;
+
+ALTER TABLE article_revisions
+    ADD COLUMN IF NOT EXISTS format VARCHAR(16) NOT NULL DEFAULT 'plain';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE article_revisions
+    DROP COLUMN IF EXISTS format;
+
+ALTER TABLE articles
+    DROP COLUMN IF EXISTS toc,
+    DROP COLUMN IF EXISTS html,
+    DROP COLUMN IF EXISTS format;
+-- +goose StatementEnd
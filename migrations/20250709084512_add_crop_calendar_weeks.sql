@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE crop_calendar
+    ADD COLUMN IF NOT EXISTS start_week SMALLINT CHECK (start_week BETWEEN 1 AND 53),
+    ADD COLUMN IF NOT EXISTS end_week   SMALLINT CHECK (end_week BETWEEN 1 AND 53),
+    ADD CONSTRAINT crop_calendar_weeks_check CHECK ((start_week IS NULL) = (end_week IS NULL));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE crop_calendar
+    DROP CONSTRAINT IF EXISTS crop_calendar_weeks_check,
+    DROP COLUMN IF EXISTS start_week,
+    DROP COLUMN IF EXISTS end_week;
+-- +goose StatementEnd
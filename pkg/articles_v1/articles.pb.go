@@ -0,0 +1,2242 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: articles.proto
+
+package articles_v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type User struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                  `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Avatar        *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Role          string                  `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_articles_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *User) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *User) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetAvatar() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Avatar
+	}
+	return nil
+}
+
+func (x *User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type CreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateResponse) Reset() {
+	*x = CreateResponse{}
+	mi := &file_articles_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateResponse) ProtoMessage() {}
+
+func (x *CreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
+func (*CreateResponse) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Crop struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Id              int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Info            *CropInfo               `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	Author          *User                   `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	RejectionReason *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	CreatedAt       *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Crop) Reset() {
+	*x = Crop{}
+	mi := &file_articles_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Crop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Crop) ProtoMessage() {}
+
+func (x *Crop) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Crop.ProtoReflect.Descriptor instead.
+func (*Crop) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Crop) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Crop) GetInfo() *CropInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *Crop) GetAuthor() *User {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *Crop) GetRejectionReason() *wrapperspb.StringValue {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return nil
+}
+
+func (x *Crop) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Crop) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CropInfo struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Name          string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Img           *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=img,proto3" json:"img,omitempty"`
+	Status        string                  `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CropInfo) Reset() {
+	*x = CropInfo{}
+	mi := &file_articles_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CropInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CropInfo) ProtoMessage() {}
+
+func (x *CropInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CropInfo.ProtoReflect.Descriptor instead.
+func (*CropInfo) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CropInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CropInfo) GetDescription() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *CropInfo) GetImg() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Img
+	}
+	return nil
+}
+
+func (x *CropInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CreateCropRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          *CropInfo              `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCropRequest) Reset() {
+	*x = CreateCropRequest{}
+	mi := &file_articles_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCropRequest) ProtoMessage() {}
+
+func (x *CreateCropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCropRequest.ProtoReflect.Descriptor instead.
+func (*CreateCropRequest) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateCropRequest) GetInfo() *CropInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type GetCropRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCropRequest) Reset() {
+	*x = GetCropRequest{}
+	mi := &file_articles_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCropRequest) ProtoMessage() {}
+
+func (x *GetCropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCropRequest.ProtoReflect.Descriptor instead.
+func (*GetCropRequest) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetCropRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetCropResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Crop          *Crop                  `protobuf:"bytes,1,opt,name=crop,proto3" json:"crop,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCropResponse) Reset() {
+	*x = GetCropResponse{}
+	mi := &file_articles_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCropResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCropResponse) ProtoMessage() {}
+
+func (x *GetCropResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCropResponse.ProtoReflect.Descriptor instead.
+func (*GetCropResponse) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetCropResponse) GetCrop() *Crop {
+	if x != nil {
+		return x.Crop
+	}
+	return nil
+}
+
+type ListCropsRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Status        *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCropsRequest) Reset() {
+	*x = ListCropsRequest{}
+	mi := &file_articles_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCropsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCropsRequest) ProtoMessage() {}
+
+func (x *ListCropsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCropsRequest.ProtoReflect.Descriptor instead.
+func (*ListCropsRequest) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListCropsRequest) GetStatus() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ListCropsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Crops         []*Crop                `protobuf:"bytes,1,rep,name=crops,proto3" json:"crops,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCropsResponse) Reset() {
+	*x = ListCropsResponse{}
+	mi := &file_articles_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCropsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCropsResponse) ProtoMessage() {}
+
+func (x *ListCropsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCropsResponse.ProtoReflect.Descriptor instead.
+func (*ListCropsResponse) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListCropsResponse) GetCrops() []*Crop {
+	if x != nil {
+		return x.Crops
+	}
+	return nil
+}
+
+type UpdateCropRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Img           *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=img,proto3" json:"img,omitempty"`
+	Status        *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCropRequest) Reset() {
+	*x = UpdateCropRequest{}
+	mi := &file_articles_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCropRequest) ProtoMessage() {}
+
+func (x *UpdateCropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCropRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCropRequest) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateCropRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCropRequest) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *UpdateCropRequest) GetDescription() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *UpdateCropRequest) GetImg() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Img
+	}
+	return nil
+}
+
+func (x *UpdateCropRequest) GetStatus() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type DeleteCropRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCropRequest) Reset() {
+	*x = DeleteCropRequest{}
+	mi := &file_articles_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCropRequest) ProtoMessage() {}
+
+func (x *DeleteCropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCropRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCropRequest) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteCropRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CropCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CropId        int64                  `protobuf:"varint,1,opt,name=crop_id,json=cropId,proto3" json:"crop_id,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CropCategoryRequest) Reset() {
+	*x = CropCategoryRequest{}
+	mi := &file_articles_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CropCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CropCategoryRequest) ProtoMessage() {}
+
+func (x *CropCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CropCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CropCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CropCategoryRequest) GetCropId() int64 {
+	if x != nil {
+		return x.CropId
+	}
+	return 0
+}
+
+func (x *CropCategoryRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type Category struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Id              int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Info            *CategoryInfo           `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	Author          *User                   `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	RejectionReason *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	CreatedAt       *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_articles_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Category) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Category) GetInfo() *CategoryInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *Category) GetAuthor() *User {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *Category) GetRejectionReason() *wrapperspb.StringValue {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return nil
+}
+
+func (x *Category) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Category) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CategoryInfo struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Name          string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Icon          *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	Status        string                  `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryInfo) Reset() {
+	*x = CategoryInfo{}
+	mi := &file_articles_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryInfo) ProtoMessage() {}
+
+func (x *CategoryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryInfo.ProtoReflect.Descriptor instead.
+func (*CategoryInfo) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CategoryInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryInfo) GetDescription() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *CategoryInfo) GetIcon() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Icon
+	}
+	return nil
+}
+
+func (x *CategoryInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          *CategoryInfo          `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	CropId        *wrapperspb.Int64Value `protobuf:"bytes,2,opt,name=crop_id,json=cropId,proto3" json:"crop_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_articles_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateCategoryRequest) GetInfo() *CategoryInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *CreateCategoryRequest) GetCropId() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.CropId
+	}
+	return nil
+}
+
+type GetCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryRequest) Reset() {
+	*x = GetCategoryRequest{}
+	mi := &file_articles_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryRequest) ProtoMessage() {}
+
+func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryResponse) Reset() {
+	*x = GetCategoryResponse{}
+	mi := &file_articles_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryResponse) ProtoMessage() {}
+
+func (x *GetCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	CropId        *wrapperspb.Int64Value  `protobuf:"bytes,1,opt,name=crop_id,json=cropId,proto3" json:"crop_id,omitempty"`
+	Status        *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_articles_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListCategoriesRequest) GetCropId() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.CropId
+	}
+	return nil
+}
+
+func (x *ListCategoriesRequest) GetStatus() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_articles_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type UpdateCategoryRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Icon          *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	Status        *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_articles_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *UpdateCategoryRequest) GetDescription() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *UpdateCategoryRequest) GetIcon() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Icon
+	}
+	return nil
+}
+
+func (x *UpdateCategoryRequest) GetStatus() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_articles_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Article struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Id              int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Body            *ArticleBody            `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Author          *User                   `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	RejectionReason *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	CreatedAt       *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Rank            *wrapperspb.FloatValue  `protobuf:"bytes,7,opt,name=rank,proto3" json:"rank,omitempty"`
+	Snippet         *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Article) Reset() {
+	*x = Article{}
+	mi := &file_articles_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Article) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Article) ProtoMessage() {}
+
+func (x *Article) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Article.ProtoReflect.Descriptor instead.
+func (*Article) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Article) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Article) GetBody() *ArticleBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *Article) GetAuthor() *User {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *Article) GetRejectionReason() *wrapperspb.StringValue {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return nil
+}
+
+func (x *Article) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Article) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Article) GetRank() *wrapperspb.FloatValue {
+	if x != nil {
+		return x.Rank
+	}
+	return nil
+}
+
+func (x *Article) GetSnippet() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Snippet
+	}
+	return nil
+}
+
+type ArticleBody struct {
+	state     protoimpl.MessageState  `protogen:"open.v1"`
+	Title     string                  `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	LatinName *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=latin_name,json=latinName,proto3" json:"latin_name,omitempty"`
+	Text      *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Images    []string                `protobuf:"bytes,4,rep,name=images,proto3" json:"images,omitempty"`
+	Status    string                  `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// format is markdown or plain, plain when empty.
+	Format string `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
+	// html and toc are rendered from text, they are ignored on input.
+	Html          *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=html,proto3" json:"html,omitempty"`
+	Toc           []*ArticleTocEntry      `protobuf:"bytes,8,rep,name=toc,proto3" json:"toc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArticleBody) Reset() {
+	*x = ArticleBody{}
+	mi := &file_articles_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArticleBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArticleBody) ProtoMessage() {}
+
+func (x *ArticleBody) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArticleBody.ProtoReflect.Descriptor instead.
+func (*ArticleBody) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ArticleBody) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ArticleBody) GetLatinName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.LatinName
+	}
+	return nil
+}
+
+func (x *ArticleBody) GetText() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Text
+	}
+	return nil
+}
+
+func (x *ArticleBody) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *ArticleBody) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ArticleBody) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ArticleBody) GetHtml() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Html
+	}
+	return nil
+}
+
+func (x *ArticleBody) GetToc() []*ArticleTocEntry {
+	if x != nil {
+		return x.Toc
+	}
+	return nil
+}
+
+type ArticleTocEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         int32                  `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Anchor        string                 `protobuf:"bytes,3,opt,name=anchor,proto3" json:"anchor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArticleTocEntry) Reset() {
+	*x = ArticleTocEntry{}
+	mi := &file_articles_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArticleTocEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArticleTocEntry) ProtoMessage() {}
+
+func (x *ArticleTocEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArticleTocEntry.ProtoReflect.Descriptor instead.
+func (*ArticleTocEntry) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ArticleTocEntry) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *ArticleTocEntry) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ArticleTocEntry) GetAnchor() string {
+	if x != nil {
+		return x.Anchor
+	}
+	return ""
+}
+
+type CreateArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CropId        int64                  `protobuf:"varint,1,opt,name=crop_id,json=cropId,proto3" json:"crop_id,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Body          *ArticleBody           `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateArticleRequest) Reset() {
+	*x = CreateArticleRequest{}
+	mi := &file_articles_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateArticleRequest) ProtoMessage() {}
+
+func (x *CreateArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateArticleRequest.ProtoReflect.Descriptor instead.
+func (*CreateArticleRequest) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateArticleRequest) GetCropId() int64 {
+	if x != nil {
+		return x.CropId
+	}
+	return 0
+}
+
+func (x *CreateArticleRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CreateArticleRequest) GetBody() *ArticleBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type GetArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArticleRequest) Reset() {
+	*x = GetArticleRequest{}
+	mi := &file_articles_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArticleRequest) ProtoMessage() {}
+
+func (x *GetArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArticleRequest.ProtoReflect.Descriptor instead.
+func (*GetArticleRequest) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetArticleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetArticleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Article       *Article               `protobuf:"bytes,1,opt,name=article,proto3" json:"article,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArticleResponse) Reset() {
+	*x = GetArticleResponse{}
+	mi := &file_articles_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArticleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArticleResponse) ProtoMessage() {}
+
+func (x *GetArticleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArticleResponse.ProtoReflect.Descriptor instead.
+func (*GetArticleResponse) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetArticleResponse) GetArticle() *Article {
+	if x != nil {
+		return x.Article
+	}
+	return nil
+}
+
+type ListArticlesRequest struct {
+	state      protoimpl.MessageState  `protogen:"open.v1"`
+	CropId     *wrapperspb.Int64Value  `protobuf:"bytes,1,opt,name=crop_id,json=cropId,proto3" json:"crop_id,omitempty"`
+	CategoryId *wrapperspb.Int64Value  `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Status     *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Query      *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	Limit      int32                   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor     *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// sort is a column name, prefixed with "-" for descending order.
+	Sort          *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArticlesRequest) Reset() {
+	*x = ListArticlesRequest{}
+	mi := &file_articles_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArticlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArticlesRequest) ProtoMessage() {}
+
+func (x *ListArticlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArticlesRequest.ProtoReflect.Descriptor instead.
+func (*ListArticlesRequest) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListArticlesRequest) GetCropId() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.CropId
+	}
+	return nil
+}
+
+func (x *ListArticlesRequest) GetCategoryId() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.CategoryId
+	}
+	return nil
+}
+
+func (x *ListArticlesRequest) GetStatus() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListArticlesRequest) GetQuery() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *ListArticlesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListArticlesRequest) GetCursor() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *ListArticlesRequest) GetSort() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+type ListArticlesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Articles      []*Article              `protobuf:"bytes,1,rep,name=articles,proto3" json:"articles,omitempty"`
+	NextCursor    *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Total         int64                   `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArticlesResponse) Reset() {
+	*x = ListArticlesResponse{}
+	mi := &file_articles_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArticlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArticlesResponse) ProtoMessage() {}
+
+func (x *ListArticlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArticlesResponse.ProtoReflect.Descriptor instead.
+func (*ListArticlesResponse) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListArticlesResponse) GetArticles() []*Article {
+	if x != nil {
+		return x.Articles
+	}
+	return nil
+}
+
+func (x *ListArticlesResponse) GetNextCursor() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NextCursor
+	}
+	return nil
+}
+
+func (x *ListArticlesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type UpdateArticleRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	LatinName     *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=latin_name,json=latinName,proto3" json:"latin_name,omitempty"`
+	Text          *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Images        []string                `protobuf:"bytes,5,rep,name=images,proto3" json:"images,omitempty"`
+	Status        *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Format        *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateArticleRequest) Reset() {
+	*x = UpdateArticleRequest{}
+	mi := &file_articles_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateArticleRequest) ProtoMessage() {}
+
+func (x *UpdateArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateArticleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateArticleRequest) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateArticleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateArticleRequest) GetTitle() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *UpdateArticleRequest) GetLatinName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.LatinName
+	}
+	return nil
+}
+
+func (x *UpdateArticleRequest) GetText() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Text
+	}
+	return nil
+}
+
+func (x *UpdateArticleRequest) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *UpdateArticleRequest) GetStatus() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *UpdateArticleRequest) GetFormat() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Format
+	}
+	return nil
+}
+
+type DeleteArticleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteArticleRequest) Reset() {
+	*x = DeleteArticleRequest{}
+	mi := &file_articles_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteArticleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteArticleRequest) ProtoMessage() {}
+
+func (x *DeleteArticleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_articles_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteArticleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteArticleRequest) Descriptor() ([]byte, []int) {
+	return file_articles_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteArticleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_articles_proto protoreflect.FileDescriptor
+
+const file_articles_proto_rawDesc = "" +
+	"\n" +
+	"\x0earticles.proto\x12\varticles_v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xa8\x01\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x120\n" +
+	"\x04name\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x124\n" +
+	"\x06avatar\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x06avatar\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\" \n" +
+	"\x0eCreateResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xab\x02\n" +
+	"\x04Crop\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12)\n" +
+	"\x04info\x18\x02 \x01(\v2\x15.articles_v1.CropInfoR\x04info\x12)\n" +
+	"\x06author\x18\x03 \x01(\v2\x11.articles_v1.UserR\x06author\x12G\n" +
+	"\x10rejection_reason\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x0frejectionReason\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa6\x01\n" +
+	"\bCropInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12>\n" +
+	"\vdescription\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\vdescription\x12.\n" +
+	"\x03img\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x03img\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\">\n" +
+	"\x11CreateCropRequest\x12)\n" +
+	"\x04info\x18\x01 \x01(\v2\x15.articles_v1.CropInfoR\x04info\" \n" +
+	"\x0eGetCropRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"8\n" +
+	"\x0fGetCropResponse\x12%\n" +
+	"\x04crop\x18\x01 \x01(\v2\x11.articles_v1.CropR\x04crop\"H\n" +
+	"\x10ListCropsRequest\x124\n" +
+	"\x06status\x18\x01 \x01(\v2\x1c.google.protobuf.StringValueR\x06status\"<\n" +
+	"\x11ListCropsResponse\x12'\n" +
+	"\x05crops\x18\x01 \x03(\v2\x11.articles_v1.CropR\x05crops\"\xfb\x01\n" +
+	"\x11UpdateCropRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x120\n" +
+	"\x04name\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x04name\x12>\n" +
+	"\vdescription\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\vdescription\x12.\n" +
+	"\x03img\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x03img\x124\n" +
+	"\x06status\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\x06status\"#\n" +
+	"\x11DeleteCropRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"O\n" +
+	"\x13CropCategoryRequest\x12\x17\n" +
+	"\acrop_id\x18\x01 \x01(\x03R\x06cropId\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x03R\n" +
+	"categoryId\"\xb3\x02\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12-\n" +
+	"\x04info\x18\x02 \x01(\v2\x19.articles_v1.CategoryInfoR\x04info\x12)\n" +
+	"\x06author\x18\x03 \x01(\v2\x11.articles_v1.UserR\x06author\x12G\n" +
+	"\x10rejection_reason\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x0frejectionReason\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xac\x01\n" +
+	"\fCategoryInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12>\n" +
+	"\vdescription\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\vdescription\x120\n" +
+	"\x04icon\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x04icon\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"|\n" +
+	"\x15CreateCategoryRequest\x12-\n" +
+	"\x04info\x18\x01 \x01(\v2\x19.articles_v1.CategoryInfoR\x04info\x124\n" +
+	"\acrop_id\x18\x02 \x01(\v2\x1b.google.protobuf.Int64ValueR\x06cropId\"$\n" +
+	"\x12GetCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"H\n" +
+	"\x13GetCategoryResponse\x121\n" +
+	"\bcategory\x18\x01 \x01(\v2\x15.articles_v1.CategoryR\bcategory\"\x83\x01\n" +
+	"\x15ListCategoriesRequest\x124\n" +
+	"\acrop_id\x18\x01 \x01(\v2\x1b.google.protobuf.Int64ValueR\x06cropId\x124\n" +
+	"\x06status\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x06status\"O\n" +
+	"\x16ListCategoriesResponse\x125\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x15.articles_v1.CategoryR\n" +
+	"categories\"\x81\x02\n" +
+	"\x15UpdateCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x120\n" +
+	"\x04name\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x04name\x12>\n" +
+	"\vdescription\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\vdescription\x120\n" +
+	"\x04icon\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x04icon\x124\n" +
+	"\x06status\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\x06status\"'\n" +
+	"\x15DeleteCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x9a\x03\n" +
+	"\aArticle\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12,\n" +
+	"\x04body\x18\x02 \x01(\v2\x18.articles_v1.ArticleBodyR\x04body\x12)\n" +
+	"\x06author\x18\x03 \x01(\v2\x11.articles_v1.UserR\x06author\x12G\n" +
+	"\x10rejection_reason\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x0frejectionReason\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12/\n" +
+	"\x04rank\x18\a \x01(\v2\x1b.google.protobuf.FloatValueR\x04rank\x126\n" +
+	"\asnippet\x18\b \x01(\v2\x1c.google.protobuf.StringValueR\asnippet\"\xbc\x02\n" +
+	"\vArticleBody\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12;\n" +
+	"\n" +
+	"latin_name\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\tlatinName\x120\n" +
+	"\x04text\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x04text\x12\x16\n" +
+	"\x06images\x18\x04 \x03(\tR\x06images\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x16\n" +
+	"\x06format\x18\x06 \x01(\tR\x06format\x120\n" +
+	"\x04html\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\x04html\x12.\n" +
+	"\x03toc\x18\b \x03(\v2\x1c.articles_v1.ArticleTocEntryR\x03toc\"S\n" +
+	"\x0fArticleTocEntry\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\x05R\x05level\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x16\n" +
+	"\x06anchor\x18\x03 \x01(\tR\x06anchor\"~\n" +
+	"\x14CreateArticleRequest\x12\x17\n" +
+	"\acrop_id\x18\x01 \x01(\x03R\x06cropId\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x03R\n" +
+	"categoryId\x12,\n" +
+	"\x04body\x18\x03 \x01(\v2\x18.articles_v1.ArticleBodyR\x04body\"#\n" +
+	"\x11GetArticleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"D\n" +
+	"\x12GetArticleResponse\x12.\n" +
+	"\aarticle\x18\x01 \x01(\v2\x14.articles_v1.ArticleR\aarticle\"\xf1\x02\n" +
+	"\x13ListArticlesRequest\x124\n" +
+	"\acrop_id\x18\x01 \x01(\v2\x1b.google.protobuf.Int64ValueR\x06cropId\x12<\n" +
+	"\vcategory_id\x18\x02 \x01(\v2\x1b.google.protobuf.Int64ValueR\n" +
+	"categoryId\x124\n" +
+	"\x06status\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x06status\x122\n" +
+	"\x05query\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x05query\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x124\n" +
+	"\x06cursor\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\x06cursor\x120\n" +
+	"\x04sort\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\x04sort\"\x9d\x01\n" +
+	"\x14ListArticlesResponse\x120\n" +
+	"\barticles\x18\x01 \x03(\v2\x14.articles_v1.ArticleR\barticles\x12=\n" +
+	"\vnext_cursor\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\n" +
+	"nextCursor\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\"\xcd\x02\n" +
+	"\x14UpdateArticleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x122\n" +
+	"\x05title\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x05title\x12;\n" +
+	"\n" +
+	"latin_name\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\tlatinName\x120\n" +
+	"\x04text\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x04text\x12\x16\n" +
+	"\x06images\x18\x05 \x03(\tR\x06images\x124\n" +
+	"\x06status\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\x06status\x124\n" +
+	"\x06format\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\x06format\"&\n" +
+	"\x14DeleteArticleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id2\xbb\n" +
+	"\n" +
+	"\n" +
+	"ArticlesV1\x12I\n" +
+	"\n" +
+	"CreateCrop\x12\x1e.articles_v1.CreateCropRequest\x1a\x1b.articles_v1.CreateResponse\x12D\n" +
+	"\aGetCrop\x12\x1b.articles_v1.GetCropRequest\x1a\x1c.articles_v1.GetCropResponse\x12J\n" +
+	"\tListCrops\x12\x1d.articles_v1.ListCropsRequest\x1a\x1e.articles_v1.ListCropsResponse\x12D\n" +
+	"\n" +
+	"UpdateCrop\x12\x1e.articles_v1.UpdateCropRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
+	"\n" +
+	"DeleteCrop\x12\x1e.articles_v1.DeleteCropRequest\x1a\x16.google.protobuf.Empty\x12K\n" +
+	"\x0fAddCropCategory\x12 .articles_v1.CropCategoryRequest\x1a\x16.google.protobuf.Empty\x12N\n" +
+	"\x12RemoveCropCategory\x12 .articles_v1.CropCategoryRequest\x1a\x16.google.protobuf.Empty\x12Q\n" +
+	"\x0eCreateCategory\x12\".articles_v1.CreateCategoryRequest\x1a\x1b.articles_v1.CreateResponse\x12P\n" +
+	"\vGetCategory\x12\x1f.articles_v1.GetCategoryRequest\x1a .articles_v1.GetCategoryResponse\x12Y\n" +
+	"\x0eListCategories\x12\".articles_v1.ListCategoriesRequest\x1a#.articles_v1.ListCategoriesResponse\x12L\n" +
+	"\x0eUpdateCategory\x12\".articles_v1.UpdateCategoryRequest\x1a\x16.google.protobuf.Empty\x12L\n" +
+	"\x0eDeleteCategory\x12\".articles_v1.DeleteCategoryRequest\x1a\x16.google.protobuf.Empty\x12O\n" +
+	"\rCreateArticle\x12!.articles_v1.CreateArticleRequest\x1a\x1b.articles_v1.CreateResponse\x12M\n" +
+	"\n" +
+	"GetArticle\x12\x1e.articles_v1.GetArticleRequest\x1a\x1f.articles_v1.GetArticleResponse\x12S\n" +
+	"\fListArticles\x12 .articles_v1.ListArticlesRequest\x1a!.articles_v1.ListArticlesResponse\x12J\n" +
+	"\rUpdateArticle\x12!.articles_v1.UpdateArticleRequest\x1a\x16.google.protobuf.Empty\x12J\n" +
+	"\rDeleteArticle\x12!.articles_v1.DeleteArticleRequest\x1a\x16.google.protobuf.EmptyBBZ@github.com/nogavadu/articles-service/pkg/articles_v1;articles_v1b\x06proto3"
+
+var (
+	file_articles_proto_rawDescOnce sync.Once
+	file_articles_proto_rawDescData []byte
+)
+
+func file_articles_proto_rawDescGZIP() []byte {
+	file_articles_proto_rawDescOnce.Do(func() {
+		file_articles_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_articles_proto_rawDesc), len(file_articles_proto_rawDesc)))
+	})
+	return file_articles_proto_rawDescData
+}
+
+var file_articles_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_articles_proto_goTypes = []any{
+	(*User)(nil),                   // 0: articles_v1.User
+	(*CreateResponse)(nil),         // 1: articles_v1.CreateResponse
+	(*Crop)(nil),                   // 2: articles_v1.Crop
+	(*CropInfo)(nil),               // 3: articles_v1.CropInfo
+	(*CreateCropRequest)(nil),      // 4: articles_v1.CreateCropRequest
+	(*GetCropRequest)(nil),         // 5: articles_v1.GetCropRequest
+	(*GetCropResponse)(nil),        // 6: articles_v1.GetCropResponse
+	(*ListCropsRequest)(nil),       // 7: articles_v1.ListCropsRequest
+	(*ListCropsResponse)(nil),      // 8: articles_v1.ListCropsResponse
+	(*UpdateCropRequest)(nil),      // 9: articles_v1.UpdateCropRequest
+	(*DeleteCropRequest)(nil),      // 10: articles_v1.DeleteCropRequest
+	(*CropCategoryRequest)(nil),    // 11: articles_v1.CropCategoryRequest
+	(*Category)(nil),               // 12: articles_v1.Category
+	(*CategoryInfo)(nil),           // 13: articles_v1.CategoryInfo
+	(*CreateCategoryRequest)(nil),  // 14: articles_v1.CreateCategoryRequest
+	(*GetCategoryRequest)(nil),     // 15: articles_v1.GetCategoryRequest
+	(*GetCategoryResponse)(nil),    // 16: articles_v1.GetCategoryResponse
+	(*ListCategoriesRequest)(nil),  // 17: articles_v1.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil), // 18: articles_v1.ListCategoriesResponse
+	(*UpdateCategoryRequest)(nil),  // 19: articles_v1.UpdateCategoryRequest
+	(*DeleteCategoryRequest)(nil),  // 20: articles_v1.DeleteCategoryRequest
+	(*Article)(nil),                // 21: articles_v1.Article
+	(*ArticleBody)(nil),            // 22: articles_v1.ArticleBody
+	(*ArticleTocEntry)(nil),        // 23: articles_v1.ArticleTocEntry
+	(*CreateArticleRequest)(nil),   // 24: articles_v1.CreateArticleRequest
+	(*GetArticleRequest)(nil),      // 25: articles_v1.GetArticleRequest
+	(*GetArticleResponse)(nil),     // 26: articles_v1.GetArticleResponse
+	(*ListArticlesRequest)(nil),    // 27: articles_v1.ListArticlesRequest
+	(*ListArticlesResponse)(nil),   // 28: articles_v1.ListArticlesResponse
+	(*UpdateArticleRequest)(nil),   // 29: articles_v1.UpdateArticleRequest
+	(*DeleteArticleRequest)(nil),   // 30: articles_v1.DeleteArticleRequest
+	(*wrapperspb.StringValue)(nil), // 31: google.protobuf.StringValue
+	(*timestamppb.Timestamp)(nil),  // 32: google.protobuf.Timestamp
+	(*wrapperspb.Int64Value)(nil),  // 33: google.protobuf.Int64Value
+	(*wrapperspb.FloatValue)(nil),  // 34: google.protobuf.FloatValue
+	(*emptypb.Empty)(nil),          // 35: google.protobuf.Empty
+}
+var file_articles_proto_depIdxs = []int32{
+	31, // 0: articles_v1.User.name:type_name -> google.protobuf.StringValue
+	31, // 1: articles_v1.User.avatar:type_name -> google.protobuf.StringValue
+	3,  // 2: articles_v1.Crop.info:type_name -> articles_v1.CropInfo
+	0,  // 3: articles_v1.Crop.author:type_name -> articles_v1.User
+	31, // 4: articles_v1.Crop.rejection_reason:type_name -> google.protobuf.StringValue
+	32, // 5: articles_v1.Crop.created_at:type_name -> google.protobuf.Timestamp
+	32, // 6: articles_v1.Crop.updated_at:type_name -> google.protobuf.Timestamp
+	31, // 7: articles_v1.CropInfo.description:type_name -> google.protobuf.StringValue
+	31, // 8: articles_v1.CropInfo.img:type_name -> google.protobuf.StringValue
+	3,  // 9: articles_v1.CreateCropRequest.info:type_name -> articles_v1.CropInfo
+	2,  // 10: articles_v1.GetCropResponse.crop:type_name -> articles_v1.Crop
+	31, // 11: articles_v1.ListCropsRequest.status:type_name -> google.protobuf.StringValue
+	2,  // 12: articles_v1.ListCropsResponse.crops:type_name -> articles_v1.Crop
+	31, // 13: articles_v1.UpdateCropRequest.name:type_name -> google.protobuf.StringValue
+	31, // 14: articles_v1.UpdateCropRequest.description:type_name -> google.protobuf.StringValue
+	31, // 15: articles_v1.UpdateCropRequest.img:type_name -> google.protobuf.StringValue
+	31, // 16: articles_v1.UpdateCropRequest.status:type_name -> google.protobuf.StringValue
+	13, // 17: articles_v1.Category.info:type_name -> articles_v1.CategoryInfo
+	0,  // 18: articles_v1.Category.author:type_name -> articles_v1.User
+	31, // 19: articles_v1.Category.rejection_reason:type_name -> google.protobuf.StringValue
+	32, // 20: articles_v1.Category.created_at:type_name -> google.protobuf.Timestamp
+	32, // 21: articles_v1.Category.updated_at:type_name -> google.protobuf.Timestamp
+	31, // 22: articles_v1.CategoryInfo.description:type_name -> google.protobuf.StringValue
+	31, // 23: articles_v1.CategoryInfo.icon:type_name -> google.protobuf.StringValue
+	13, // 24: articles_v1.CreateCategoryRequest.info:type_name -> articles_v1.CategoryInfo
+	33, // 25: articles_v1.CreateCategoryRequest.crop_id:type_name -> google.protobuf.Int64Value
+	12, // 26: articles_v1.GetCategoryResponse.category:type_name -> articles_v1.Category
+	33, // 27: articles_v1.ListCategoriesRequest.crop_id:type_name -> google.protobuf.Int64Value
+	31, // 28: articles_v1.ListCategoriesRequest.status:type_name -> google.protobuf.StringValue
+	12, // 29: articles_v1.ListCategoriesResponse.categories:type_name -> articles_v1.Category
+	31, // 30: articles_v1.UpdateCategoryRequest.name:type_name -> google.protobuf.StringValue
+	31, // 31: articles_v1.UpdateCategoryRequest.description:type_name -> google.protobuf.StringValue
+	31, // 32: articles_v1.UpdateCategoryRequest.icon:type_name -> google.protobuf.StringValue
+	31, // 33: articles_v1.UpdateCategoryRequest.status:type_name -> google.protobuf.StringValue
+	22, // 34: articles_v1.Article.body:type_name -> articles_v1.ArticleBody
+	0,  // 35: articles_v1.Article.author:type_name -> articles_v1.User
+	31, // 36: articles_v1.Article.rejection_reason:type_name -> google.protobuf.StringValue
+	32, // 37: articles_v1.Article.created_at:type_name -> google.protobuf.Timestamp
+	32, // 38: articles_v1.Article.updated_at:type_name -> google.protobuf.Timestamp
+	34, // 39: articles_v1.Article.rank:type_name -> google.protobuf.FloatValue
+	31, // 40: articles_v1.Article.snippet:type_name -> google.protobuf.StringValue
+	31, // 41: articles_v1.ArticleBody.latin_name:type_name -> google.protobuf.StringValue
+	31, // 42: articles_v1.ArticleBody.text:type_name -> google.protobuf.StringValue
+	31, // 43: articles_v1.ArticleBody.html:type_name -> google.protobuf.StringValue
+	23, // 44: articles_v1.ArticleBody.toc:type_name -> articles_v1.ArticleTocEntry
+	22, // 45: articles_v1.CreateArticleRequest.body:type_name -> articles_v1.ArticleBody
+	21, // 46: articles_v1.GetArticleResponse.article:type_name -> articles_v1.Article
+	33, // 47: articles_v1.ListArticlesRequest.crop_id:type_name -> google.protobuf.Int64Value
+	33, // 48: articles_v1.ListArticlesRequest.category_id:type_name -> google.protobuf.Int64Value
+	31, // 49: articles_v1.ListArticlesRequest.status:type_name -> google.protobuf.StringValue
+	31, // 50: articles_v1.ListArticlesRequest.query:type_name -> google.protobuf.StringValue
+	31, // 51: articles_v1.ListArticlesRequest.cursor:type_name -> google.protobuf.StringValue
+	31, // 52: articles_v1.ListArticlesRequest.sort:type_name -> google.protobuf.StringValue
+	21, // 53: articles_v1.ListArticlesResponse.articles:type_name -> articles_v1.Article
+	31, // 54: articles_v1.ListArticlesResponse.next_cursor:type_name -> google.protobuf.StringValue
+	31, // 55: articles_v1.UpdateArticleRequest.title:type_name -> google.protobuf.StringValue
+	31, // 56: articles_v1.UpdateArticleRequest.latin_name:type_name -> google.protobuf.StringValue
+	31, // 57: articles_v1.UpdateArticleRequest.text:type_name -> google.protobuf.StringValue
+	31, // 58: articles_v1.UpdateArticleRequest.status:type_name -> google.protobuf.StringValue
+	31, // 59: articles_v1.UpdateArticleRequest.format:type_name -> google.protobuf.StringValue
+	4,  // 60: articles_v1.ArticlesV1.CreateCrop:input_type -> articles_v1.CreateCropRequest
+	5,  // 61: articles_v1.ArticlesV1.GetCrop:input_type -> articles_v1.GetCropRequest
+	7,  // 62: articles_v1.ArticlesV1.ListCrops:input_type -> articles_v1.ListCropsRequest
+	9,  // 63: articles_v1.ArticlesV1.UpdateCrop:input_type -> articles_v1.UpdateCropRequest
+	10, // 64: articles_v1.ArticlesV1.DeleteCrop:input_type -> articles_v1.DeleteCropRequest
+	11, // 65: articles_v1.ArticlesV1.AddCropCategory:input_type -> articles_v1.CropCategoryRequest
+	11, // 66: articles_v1.ArticlesV1.RemoveCropCategory:input_type -> articles_v1.CropCategoryRequest
+	14, // 67: articles_v1.ArticlesV1.CreateCategory:input_type -> articles_v1.CreateCategoryRequest
+	15, // 68: articles_v1.ArticlesV1.GetCategory:input_type -> articles_v1.GetCategoryRequest
+	17, // 69: articles_v1.ArticlesV1.ListCategories:input_type -> articles_v1.ListCategoriesRequest
+	19, // 70: articles_v1.ArticlesV1.UpdateCategory:input_type -> articles_v1.UpdateCategoryRequest
+	20, // 71: articles_v1.ArticlesV1.DeleteCategory:input_type -> articles_v1.DeleteCategoryRequest
+	24, // 72: articles_v1.ArticlesV1.CreateArticle:input_type -> articles_v1.CreateArticleRequest
+	25, // 73: articles_v1.ArticlesV1.GetArticle:input_type -> articles_v1.GetArticleRequest
+	27, // 74: articles_v1.ArticlesV1.ListArticles:input_type -> articles_v1.ListArticlesRequest
+	29, // 75: articles_v1.ArticlesV1.UpdateArticle:input_type -> articles_v1.UpdateArticleRequest
+	30, // 76: articles_v1.ArticlesV1.DeleteArticle:input_type -> articles_v1.DeleteArticleRequest
+	1,  // 77: articles_v1.ArticlesV1.CreateCrop:output_type -> articles_v1.CreateResponse
+	6,  // 78: articles_v1.ArticlesV1.GetCrop:output_type -> articles_v1.GetCropResponse
+	8,  // 79: articles_v1.ArticlesV1.ListCrops:output_type -> articles_v1.ListCropsResponse
+	35, // 80: articles_v1.ArticlesV1.UpdateCrop:output_type -> google.protobuf.Empty
+	35, // 81: articles_v1.ArticlesV1.DeleteCrop:output_type -> google.protobuf.Empty
+	35, // 82: articles_v1.ArticlesV1.AddCropCategory:output_type -> google.protobuf.Empty
+	35, // 83: articles_v1.ArticlesV1.RemoveCropCategory:output_type -> google.protobuf.Empty
+	1,  // 84: articles_v1.ArticlesV1.CreateCategory:output_type -> articles_v1.CreateResponse
+	16, // 85: articles_v1.ArticlesV1.GetCategory:output_type -> articles_v1.GetCategoryResponse
+	18, // 86: articles_v1.ArticlesV1.ListCategories:output_type -> articles_v1.ListCategoriesResponse
+	35, // 87: articles_v1.ArticlesV1.UpdateCategory:output_type -> google.protobuf.Empty
+	35, // 88: articles_v1.ArticlesV1.DeleteCategory:output_type -> google.protobuf.Empty
+	1,  // 89: articles_v1.ArticlesV1.CreateArticle:output_type -> articles_v1.CreateResponse
+	26, // 90: articles_v1.ArticlesV1.GetArticle:output_type -> articles_v1.GetArticleResponse
+	28, // 91: articles_v1.ArticlesV1.ListArticles:output_type -> articles_v1.ListArticlesResponse
+	35, // 92: articles_v1.ArticlesV1.UpdateArticle:output_type -> google.protobuf.Empty
+	35, // 93: articles_v1.ArticlesV1.DeleteArticle:output_type -> google.protobuf.Empty
+	77, // [77:94] is the sub-list for method output_type
+	60, // [60:77] is the sub-list for method input_type
+	60, // [60:60] is the sub-list for extension type_name
+	60, // [60:60] is the sub-list for extension extendee
+	0,  // [0:60] is the sub-list for field type_name
+}
+
+func init() { file_articles_proto_init() }
+func file_articles_proto_init() {
+	if File_articles_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_articles_proto_rawDesc), len(file_articles_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   31,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_articles_proto_goTypes,
+		DependencyIndexes: file_articles_proto_depIdxs,
+		MessageInfos:      file_articles_proto_msgTypes,
+	}.Build()
+	File_articles_proto = out.File
+	file_articles_proto_goTypes = nil
+	file_articles_proto_depIdxs = nil
+}